@@ -1,32 +1,78 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"path/filepath"
+	"strings"
 
 	"github.com/haigeek/douban-api-go/internal/api/movie"
 	"github.com/haigeek/douban-api-go/internal/book"
 	"github.com/haigeek/douban-api-go/internal/config"
+	"github.com/haigeek/douban-api-go/internal/drama"
+	"github.com/haigeek/douban-api-go/internal/game"
+	"github.com/haigeek/douban-api-go/internal/graphql"
 	"github.com/haigeek/douban-api-go/internal/httpclient"
 	"github.com/haigeek/douban-api-go/internal/media"
+	"github.com/haigeek/douban-api-go/internal/music"
+	"github.com/haigeek/douban-api-go/internal/plex"
 	"github.com/haigeek/douban-api-go/internal/server"
+	"github.com/haigeek/douban-api-go/internal/store"
+	"github.com/haigeek/douban-api-go/internal/suggest"
+	"github.com/haigeek/douban-api-go/internal/tmdb"
 )
 
 func main() {
 	cfg := config.Load()
 
-	client, err := httpclient.New(cfg.Cookie)
+	client, err := httpclient.New(cfg.Cookie, cfg.RateLimit)
 	if err != nil {
 		log.Fatalf("create http client failed: %v", err)
 	}
 
-	movieService := movie.NewService(client)
+	imdbStore, err := store.Open(filepath.Join(cfg.DataDir, "imdb.json"))
+	if err != nil {
+		log.Fatalf("open imdb store failed: %v", err)
+	}
+
+	seenStore, err := store.Open(filepath.Join(cfg.DataDir, "feed_seen.json"))
+	if err != nil {
+		log.Fatalf("open feed store failed: %v", err)
+	}
+
+	movieService := movie.NewService(client, imdbStore)
 	bookService := book.NewService(client)
-	mediaService := media.NewService(client)
+	mediaService := media.NewService(client, movieService, seenStore)
+	suggestService := suggest.NewService(client)
+	musicService := music.NewService(client)
+	gameService := game.NewService(client)
+	dramaService := drama.NewService(client)
 	h := server.NewHandlers(movieService, cfg)
 	b := book.NewHandlers(bookService)
-	m := media.NewHandlers(mediaService)
-	r := server.NewRouter(h, b, m, cfg.Debug)
+	snapshotter := media.NewSnapshotter(mediaService, filepath.Join(cfg.DataDir, "snapshots"), strings.Split(cfg.SnapshotLists, ","))
+	if cfg.SnapshotInterval > 0 {
+		log.Printf("hot list snapshots every %s, stored in %s", cfg.SnapshotInterval, filepath.Join(cfg.DataDir, "snapshots"))
+		go snapshotter.Run(context.Background(), cfg.SnapshotInterval)
+	}
+	m := media.NewHandlers(mediaService, snapshotter)
+	sg := suggest.NewHandlers(suggestService)
+	mu := music.NewHandlers(musicService)
+	gm := game.NewHandlers(gameService)
+	dr := drama.NewHandlers(dramaService)
+	gq, err := graphql.NewHandlers(movieService, bookService, mediaService)
+	if err != nil {
+		log.Fatalf("build graphql schema failed: %v", err)
+	}
+	var px *plex.Handlers
+	if cfg.Plex {
+		px = plex.NewHandlers(movieService)
+	}
+	var tm *tmdb.Handlers
+	if cfg.TMDB {
+		tm = tmdb.NewHandlers(movieService)
+	}
+	r := server.NewRouter(h, b, m, sg, mu, gm, dr, tm, px, gq, cfg.Debug)
 
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	if err := r.Run(addr); err != nil {
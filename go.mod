@@ -5,6 +5,7 @@ go 1.21
 require (
 	github.com/PuerkitoBio/goquery v1.8.1
 	github.com/gin-gonic/gin v1.10.0
+	github.com/graph-gophers/graphql-go v1.7.2
 	github.com/hashicorp/golang-lru/v2 v2.0.7
 )
 
@@ -0,0 +1,31 @@
+package movie
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	reFieldDate   = regexp.MustCompile(`[0-9]{4}-[0-9]{2}-[0-9]{2}`)
+	reFieldNumber = regexp.MustCompile(`[0-9]+`)
+)
+
+func SplitList(raw string) []string {
+	list := make([]string, 0)
+	for _, it := range strings.Split(raw, "/") {
+		if v := strings.TrimSpace(it); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func FirstDate(raw string) string {
+	return reFieldDate.FindString(raw)
+}
+
+func FirstNumber(raw string) int {
+	n, _ := strconv.Atoi(reFieldNumber.FindString(raw))
+	return n
+}
@@ -0,0 +1,166 @@
+package movie
+
+import (
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	reFileYear       = regexp.MustCompile(`^[(\[]?((?:19|20)[0-9]{2})[)\]]?$`)
+	reFileSeasonEp   = regexp.MustCompile(`(?i)^S([0-9]{1,2})E([0-9]{1,3})(?:-?E[0-9]{1,3})*$`)
+	reFileSeason     = regexp.MustCompile(`(?i)^S(?:eason)?([0-9]{1,2})$`)
+	reFileEpisode    = regexp.MustCompile(`(?i)^(?:E|EP)([0-9]{1,3})$`)
+	reFileCnSeason   = regexp.MustCompile(`第([0-9一二三四五六七八九十]+)季`)
+	reFileCnEpisode  = regexp.MustCompile(`第([0-9]+)[集话話]`)
+	reFileResolution = regexp.MustCompile(`(?i)^(?:[0-9]{3,4}[pi]|[48]k|uhd)$`)
+	reFileSeparator  = regexp.MustCompile(`[._\s]+`)
+	reFileGroup      = regexp.MustCompile(`^\s*[\[【][^\]】]*[\]】]`)
+)
+
+var videoExtensions = map[string]bool{
+	".mkv": true, ".mp4": true, ".avi": true, ".ts": true, ".m2ts": true,
+	".rmvb": true, ".wmv": true, ".mov": true, ".flv": true, ".iso": true,
+	".strm": true, ".webm": true,
+}
+
+var releaseTags = map[string]bool{
+	"web-dl": true, "webdl": true, "webrip": true, "web": true, "bluray": true,
+	"blu-ray": true, "bdrip": true, "brrip": true, "remux": true, "hdtv": true,
+	"dvdrip": true, "hdrip": true, "hdr": true, "hdr10": true, "dv": true,
+	"dovi": true, "x264": true, "x265": true, "h264": true, "h265": true,
+	"h.264": true, "h.265": true, "hevc": true, "avc": true, "aac": true,
+	"ac3": true, "dts": true, "dts-hd": true, "truehd": true, "atmos": true,
+	"ddp5": true, "dd5": true, "10bit": true, "8bit": true, "proper": true,
+	"repack": true, "extended": true, "uncut": true, "complete": true,
+	"internal": true, "limited": true, "imax": true, "nf": true, "amzn": true,
+	"dsnp": true, "hmax": true, "atvp": true, "中字": true, "国语": true,
+	"粤语": true, "双语": true, "中英字幕": true, "国粤双语": true,
+}
+
+func ParseFileName(name string) ParsedName {
+	parsed := ParsedName{Raw: name, Tags: []string{}}
+
+	base := path.Base(strings.ReplaceAll(name, `\`, "/"))
+	if ext := strings.ToLower(path.Ext(base)); videoExtensions[ext] {
+		base = strings.TrimSuffix(base, path.Ext(base))
+	}
+
+	base = reFileGroup.ReplaceAllString(base, "")
+	if m := reFileCnSeason.FindStringSubmatch(base); len(m) == 2 {
+		parsed.Season = parseChineseNumber(m[1])
+		base = strings.Replace(base, m[0], " ", 1)
+	}
+	if m := reFileCnEpisode.FindStringSubmatch(base); len(m) == 2 {
+		parsed.Episode, _ = strconv.Atoi(m[1])
+		base = strings.Replace(base, m[0], " ", 1)
+	}
+
+	base = strings.NewReplacer("[", " ", "]", " ", "【", " ", "】", " ").Replace(base)
+	tokens := reFileSeparator.Split(strings.TrimSpace(base), -1)
+
+	titleTokens := make([]string, 0, len(tokens))
+	titleDone := false
+	yearIndex := -1
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		lower := strings.ToLower(token)
+		switch {
+		case reFileYear.MatchString(token) && !titleDone:
+			// Only the last year before the release markers is the release year;
+			// earlier ones belong to the title, e.g. "Blade.Runner.2049.2017".
+			if len(titleTokens) > 0 {
+				yearIndex = len(titleTokens)
+			}
+			titleTokens = append(titleTokens, token)
+		case reFileYear.MatchString(token):
+			if parsed.Year == "" && yearIndex < 0 {
+				parsed.Year = reFileYear.FindStringSubmatch(token)[1]
+			}
+		case reFileSeasonEp.MatchString(token):
+			m := reFileSeasonEp.FindStringSubmatch(token)
+			parsed.Season, _ = strconv.Atoi(m[1])
+			parsed.Episode, _ = strconv.Atoi(m[2])
+			titleDone = true
+		case reFileSeason.MatchString(token):
+			parsed.Season, _ = strconv.Atoi(reFileSeason.FindStringSubmatch(token)[1])
+			titleDone = true
+		case reFileEpisode.MatchString(token):
+			parsed.Episode, _ = strconv.Atoi(reFileEpisode.FindStringSubmatch(token)[1])
+			titleDone = true
+		case reFileResolution.MatchString(token):
+			parsed.Resolution = lower
+			titleDone = true
+		case releaseTags[lower] || releaseTags[strings.SplitN(lower, "-", 2)[0]]:
+			parsed.Tags = append(parsed.Tags, token)
+			titleDone = true
+		case !titleDone:
+			titleTokens = append(titleTokens, token)
+		}
+	}
+
+	if yearIndex > 0 {
+		parsed.Year = reFileYear.FindStringSubmatch(titleTokens[yearIndex])[1]
+		titleTokens = titleTokens[:yearIndex]
+	}
+	parsed.Title = strings.TrimSpace(strings.Join(titleTokens, " "))
+	parsed.LocalTitle, parsed.ForeignTitle = splitTitleScripts(parsed.Title)
+	return parsed
+}
+
+func (p ParsedName) SearchQuery() string {
+	if p.LocalTitle != "" {
+		return p.LocalTitle
+	}
+	return p.Title
+}
+
+func splitTitleScripts(title string) (local string, foreign string) {
+	localParts := make([]string, 0)
+	foreignParts := make([]string, 0)
+	for _, word := range strings.Fields(title) {
+		if containsHan(word) {
+			localParts = append(localParts, word)
+		} else {
+			foreignParts = append(foreignParts, word)
+		}
+	}
+	if len(localParts) == 0 {
+		return "", title
+	}
+	return strings.Join(localParts, " "), strings.Join(foreignParts, " ")
+}
+
+func containsHan(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseChineseNumber(s string) int {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+	digits := map[rune]int{'一': 1, '二': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9}
+	total := 0
+	current := 0
+	for _, r := range s {
+		if r == '十' {
+			if current == 0 {
+				current = 1
+			}
+			total += current * 10
+			current = 0
+			continue
+		}
+		current = digits[r]
+	}
+	return total + current
+}
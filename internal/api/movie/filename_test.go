@@ -0,0 +1,75 @@
+package movie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFileName(t *testing.T) {
+	tests := []struct {
+		name string
+		want ParsedName
+	}{
+		{
+			name: "The.Wandering.Earth.2019.2160p.WEB-DL.mkv",
+			want: ParsedName{Title: "The Wandering Earth", ForeignTitle: "The Wandering Earth", Year: "2019", Resolution: "2160p", Tags: []string{"WEB-DL"}},
+		},
+		{
+			name: "1917.2019.1080p.BluRay.x264",
+			want: ParsedName{Title: "1917", ForeignTitle: "1917", Year: "2019", Resolution: "1080p", Tags: []string{"BluRay", "x264"}},
+		},
+		{
+			name: "1917.mkv",
+			want: ParsedName{Title: "1917", ForeignTitle: "1917", Tags: []string{}},
+		},
+		{
+			name: "Blade.Runner.2049.2017.2160p.UHD.BluRay",
+			want: ParsedName{Title: "Blade Runner 2049", ForeignTitle: "Blade Runner 2049", Year: "2017", Resolution: "uhd", Tags: []string{"BluRay"}},
+		},
+		{
+			name: "Blade.Runner.2049.2160p",
+			want: ParsedName{Title: "Blade Runner", ForeignTitle: "Blade Runner", Year: "2049", Resolution: "2160p", Tags: []string{}},
+		},
+		{
+			name: "Inception (2010) [1080p].mp4",
+			want: ParsedName{Title: "Inception", ForeignTitle: "Inception", Year: "2010", Resolution: "1080p", Tags: []string{}},
+		},
+		{
+			name: "Friends.S01E02.720p.HDTV",
+			want: ParsedName{Title: "Friends", ForeignTitle: "Friends", Season: 1, Episode: 2, Resolution: "720p", Tags: []string{"HDTV"}},
+		},
+		{
+			name: "Friends.S01E02.1994.720p",
+			want: ParsedName{Title: "Friends", ForeignTitle: "Friends", Year: "1994", Season: 1, Episode: 2, Resolution: "720p", Tags: []string{}},
+		},
+		{
+			name: "The.Office.Season2.E05",
+			want: ParsedName{Title: "The Office", ForeignTitle: "The Office", Season: 2, Episode: 5, Tags: []string{}},
+		},
+		{
+			name: "庆余年 第二季 第05集 4K.mp4",
+			want: ParsedName{Title: "庆余年", LocalTitle: "庆余年", Season: 2, Episode: 5, Resolution: "4k", Tags: []string{}},
+		},
+		{
+			name: "[字幕组] 流浪地球.The.Wandering.Earth.2019.1080p.国语中字.mkv",
+			want: ParsedName{Title: "流浪地球 The Wandering Earth", LocalTitle: "流浪地球", ForeignTitle: "The Wandering Earth", Year: "2019", Resolution: "1080p", Tags: []string{}},
+		},
+		{
+			name: "【高清】让子弹飞 Let the Bullets Fly 2010 BluRay 中字",
+			want: ParsedName{Title: "让子弹飞 Let the Bullets Fly", LocalTitle: "让子弹飞", ForeignTitle: "Let the Bullets Fly", Year: "2010", Tags: []string{"BluRay", "中字"}},
+		},
+		{
+			name: `D:\Movies\Heat.1995.REMUX.mkv`,
+			want: ParsedName{Title: "Heat", ForeignTitle: "Heat", Year: "1995", Tags: []string{"REMUX"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Raw = tt.name
+			if got := ParseFileName(tt.name); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFileName(%q)\n got  %+v\n want %+v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
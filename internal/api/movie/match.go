@@ -0,0 +1,191 @@
+package movie
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	matchCandidateLimit       = 5
+	matchCandidateConcurrency = 3
+)
+
+func (s *Service) Match(ctx context.Context, fileName, imageSize string) (MatchResult, error) {
+	return s.MatchParsed(ctx, ParseFileName(fileName), imageSize)
+}
+
+func (s *Service) MatchParsed(ctx context.Context, parsed ParsedName, imageSize string) (MatchResult, error) {
+	result := MatchResult{Query: parsed, Alternatives: []MatchCandidate{}}
+
+	query := parsed.SearchQuery()
+	if query == "" {
+		return result, nil
+	}
+
+	movies, err := s.Search(ctx, query, matchCandidateLimit, imageSize)
+	if err != nil {
+		return MatchResult{}, err
+	}
+	if len(movies) == 0 && parsed.LocalTitle != "" && parsed.ForeignTitle != "" {
+		movies, err = s.Search(ctx, parsed.ForeignTitle, matchCandidateLimit, imageSize)
+		if err != nil {
+			return MatchResult{}, err
+		}
+	}
+
+	candidates := matchCandidates(ctx, parsed, movies, func(ctx context.Context, sid string) (MovieInfo, error) {
+		return s.GetMovieInfo(ctx, sid, imageSize)
+	})
+	if err := ctx.Err(); err != nil {
+		return MatchResult{}, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	if len(candidates) > 0 {
+		best := candidates[0]
+		result.Best = &best
+		result.Confidence = best.Score
+		result.Alternatives = candidates[1:]
+	}
+	return result, nil
+}
+
+func matchCandidates(ctx context.Context, parsed ParsedName, movies []Movie, fetch func(context.Context, string) (MovieInfo, error)) []MatchCandidate {
+	found := make([]*MatchCandidate, len(movies))
+	sem := make(chan struct{}, matchCandidateConcurrency)
+	var wg sync.WaitGroup
+
+	for i, m := range movies {
+		wg.Add(1)
+		go func(i int, m Movie) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			info, err := fetch(ctx, m.SID)
+			if err != nil {
+				log.Printf("match candidate %s failed: %v", m.SID, err)
+				return
+			}
+			found[i] = &MatchCandidate{
+				SID:          m.SID,
+				Cat:          m.Cat,
+				Name:         info.Name,
+				OriginalName: info.OriginalName,
+				Year:         info.Year,
+				Rating:       info.Rating,
+				Img:          info.Img,
+				Score:        scoreCandidate(parsed, m.Cat, info),
+			}
+		}(i, m)
+	}
+	wg.Wait()
+
+	candidates := make([]MatchCandidate, 0, len(movies))
+	for _, c := range found {
+		if c != nil {
+			candidates = append(candidates, *c)
+		}
+	}
+	return candidates
+}
+
+func scoreCandidate(parsed ParsedName, cat string, info MovieInfo) float64 {
+	titles := []string{info.Name, info.OriginalName}
+	for _, alias := range strings.Split(info.Subname, "/") {
+		titles = append(titles, alias)
+	}
+
+	titleScore := 0.0
+	for _, queryTitle := range []string{parsed.Title, parsed.LocalTitle, parsed.ForeignTitle} {
+		if queryTitle == "" {
+			continue
+		}
+		for _, title := range titles {
+			titleScore = math.Max(titleScore, titleSimilarity(queryTitle, title))
+		}
+	}
+
+	yearScore := 0.5
+	if parsed.Year != "" {
+		want, _ := strconv.Atoi(parsed.Year)
+		got, err := strconv.Atoi(info.Year)
+		switch {
+		case err != nil:
+			yearScore = 0.3
+		case got == want:
+			yearScore = 1
+		case got-want == 1 || want-got == 1:
+			yearScore = 0.6
+		default:
+			yearScore = 0
+		}
+	}
+
+	typeScore := 0.5
+	isSeries := parsed.Season > 0 || parsed.Episode > 0
+	if isSeries && cat == "电视剧" || !isSeries && cat == "电影" {
+		typeScore = 1
+	} else if isSeries && cat == "电影" {
+		typeScore = 0
+	}
+
+	score := 0.65*titleScore + 0.25*yearScore + 0.1*typeScore
+	return math.Round(score*1000) / 1000
+}
+
+func titleSimilarity(a, b string) float64 {
+	na := normalizeTitle(a)
+	nb := normalizeTitle(b)
+	if len(na) == 0 || len(nb) == 0 {
+		return 0
+	}
+	if string(na) == string(nb) {
+		return 1
+	}
+	dist := levenshtein(na, nb)
+	longest := len(na)
+	if len(nb) > longest {
+		longest = len(nb)
+	}
+	return 1 - float64(dist)/float64(longest)
+}
+
+func normalizeTitle(s string) []rune {
+	out := make([]rune, 0, len(s))
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
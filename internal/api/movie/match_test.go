@@ -0,0 +1,53 @@
+package movie
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMatchCandidates(t *testing.T) {
+	movies := []Movie{
+		{SID: "1", Cat: "电影"},
+		{SID: "2", Cat: "电影"},
+		{SID: "3", Cat: "电影"},
+		{SID: "4", Cat: "电视剧"},
+		{SID: "5", Cat: "电影"},
+	}
+	var running, peak atomic.Int64
+	fetch := func(_ context.Context, sid string) (MovieInfo, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if sid == "2" {
+			return MovieInfo{}, errors.New("upstream failed")
+		}
+		return MovieInfo{Name: "Movie " + sid, Year: "2019"}, nil
+	}
+
+	parsed := ParsedName{Title: "Movie 3", Year: "2019"}
+	got := matchCandidates(context.Background(), parsed, movies, fetch)
+
+	var sids []string
+	for _, c := range got {
+		sids = append(sids, c.SID)
+	}
+	if want := []string{"1", "3", "4", "5"}; !reflect.DeepEqual(sids, want) {
+		t.Fatalf("sids = %v, want %v", sids, want)
+	}
+	if got[1].Name != "Movie 3" || got[1].Score <= got[0].Score {
+		t.Errorf("candidate 3 = %+v, want the best score", got[1])
+	}
+	if p := peak.Load(); p > matchCandidateConcurrency || p < 2 {
+		t.Errorf("peak concurrency = %d, want 2..%d", p, matchCandidateConcurrency)
+	}
+}
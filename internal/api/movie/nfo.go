@@ -0,0 +1,142 @@
+package movie
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"log"
+	"strconv"
+	"strings"
+)
+
+const (
+	NFOMovie  = "movie"
+	NFOTVShow = "tvshow"
+	NFOSuffix = ".nfo"
+)
+
+func (s *Service) NFO(ctx context.Context, info MovieInfo, kind string) []byte {
+	kind = nfoKind(info, kind)
+	celebrities, err := s.GetCelebrities(ctx, info.SID)
+	if err != nil {
+		log.Printf("nfo %s: fetch celebrities failed: %v", info.SID, err)
+	}
+	if len(celebrities) == 0 {
+		celebrities = info.Celebrities
+	}
+	photos, err := s.GetWallpaper(ctx, info.SID)
+	if err != nil {
+		log.Printf("nfo %s: fetch wallpapers failed: %v", info.SID, err)
+		photos = nil
+	}
+	return renderNFO(info, kind, celebrities, photos)
+}
+
+func nfoKind(info MovieInfo, kind string) string {
+	if kind != "" {
+		return kind
+	}
+	if info.Episodes != "" {
+		return NFOTVShow
+	}
+	return NFOMovie
+}
+
+func renderNFO(info MovieInfo, kind string, celebrities []Celebrity, photos []Photo) []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString("<" + kind + ">\n")
+
+	writeNFOElem(&b, 1, "title", info.Name)
+	originalTitle := info.OriginalName
+	if originalTitle == "" {
+		originalTitle = info.Name
+	}
+	writeNFOElem(&b, 1, "originaltitle", originalTitle)
+	writeNFOElem(&b, 1, "year", info.Year)
+	writeNFOElem(&b, 1, "plot", info.Intro)
+
+	b.WriteString("  <ratings>\n")
+	b.WriteString(`    <rating name="douban" max="10" default="true">` + "\n")
+	writeNFOElem(&b, 3, "value", info.Rating)
+	writeNFOElem(&b, 3, "votes", info.Votes)
+	b.WriteString("    </rating>\n")
+	b.WriteString("  </ratings>\n")
+
+	for _, genre := range SplitList(info.Genre) {
+		writeNFOElem(&b, 1, "genre", genre)
+	}
+	for _, country := range SplitList(info.Country) {
+		writeNFOElem(&b, 1, "country", country)
+	}
+	for _, director := range SplitList(info.Director) {
+		writeNFOElem(&b, 1, "director", director)
+	}
+	for _, writer := range SplitList(info.Writer) {
+		writeNFOElem(&b, 1, "credits", writer)
+	}
+
+	writeNFOElem(&b, 1, "uniqueid", info.SID, "type", "douban", "default", "true")
+	if info.IMDB != "" {
+		writeNFOElem(&b, 1, "uniqueid", info.IMDB, "type", "imdb")
+	}
+	if premiered := FirstDate(info.Screen); premiered != "" {
+		writeNFOElem(&b, 1, "premiered", premiered)
+	}
+	if runtime := FirstNumber(info.Duration); runtime > 0 {
+		writeNFOElem(&b, 1, "runtime", strconv.Itoa(runtime))
+	}
+	if info.Img != "" {
+		writeNFOElem(&b, 1, "thumb", info.Img, "aspect", "poster")
+	}
+	if len(photos) > 0 {
+		b.WriteString("  <fanart>\n")
+		for _, photo := range photos {
+			writeNFOElem(&b, 2, "thumb", photo.Large, "preview", photo.Medium)
+		}
+		b.WriteString("  </fanart>\n")
+	}
+
+	for i, actor := range nfoActors(info, celebrities) {
+		b.WriteString("  <actor>\n")
+		writeNFOElem(&b, 2, "name", actor.Name)
+		writeNFOElem(&b, 2, "role", actor.Role)
+		writeNFOElem(&b, 2, "order", strconv.Itoa(i))
+		writeNFOElem(&b, 2, "thumb", actor.Img)
+		b.WriteString("  </actor>\n")
+	}
+
+	b.WriteString("</" + kind + ">\n")
+	return b.Bytes()
+}
+
+func nfoActors(info MovieInfo, celebrities []Celebrity) []Celebrity {
+	actors := make([]Celebrity, 0, len(celebrities))
+	for _, c := range celebrities {
+		if c.RoleType == "演员" || c.RoleType == "配音" {
+			actors = append(actors, c)
+		}
+	}
+	if len(actors) > 0 {
+		return actors
+	}
+	for _, name := range SplitList(info.Actor) {
+		actors = append(actors, Celebrity{Name: name})
+	}
+	return actors
+}
+
+func writeNFOElem(b *bytes.Buffer, depth int, name, text string, attrs ...string) {
+	if text == "" {
+		return
+	}
+	b.WriteString(strings.Repeat("  ", depth) + "<" + name)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		b.WriteString(" " + attrs[i] + `="`)
+		_ = xml.EscapeText(b, []byte(attrs[i+1]))
+		b.WriteString(`"`)
+	}
+	b.WriteString(">")
+	_ = xml.EscapeText(b, []byte(text))
+	b.WriteString("</" + name + ">\n")
+}
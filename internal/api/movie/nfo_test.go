@@ -0,0 +1,95 @@
+package movie
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestRenderNFO(t *testing.T) {
+	film := MovieInfo{
+		SID:          "1292052",
+		Name:         "肖申克的救赎",
+		OriginalName: "The Shawshank Redemption",
+		Rating:       "9.7",
+		Votes:        "3000000",
+		Img:          "https://img2.doubanio.com/view/photo/s_ratio_poster/public/p480747492.jpg",
+		Year:         "1994",
+		Intro:        "希望 & \"自由\" <无价>",
+		Director:     "弗兰克·德拉邦特",
+		Writer:       "弗兰克·德拉邦特 / 斯蒂芬·金",
+		Actor:        "蒂姆·罗宾斯 / 摩根·弗里曼",
+		Genre:        "剧情 / 犯罪",
+		Country:      "美国",
+		Screen:       "1994-09-10(多伦多电影节) / 1994-10-14(美国)",
+		Duration:     "142分钟",
+		IMDB:         "tt0111161",
+	}
+	celebrities := []Celebrity{
+		{Name: "弗兰克·德拉邦特", RoleType: "导演", Role: "导演"},
+		{Name: "蒂姆·罗宾斯", RoleType: "演员", Role: "饰 Andy <Dufresne>", Img: "https://img2.doubanio.com/view/celebrity/raw/public/p17525.jpg"},
+		{Name: "Tom & Jerry", RoleType: "配音", Role: "配音"},
+	}
+	photos := []Photo{{
+		Medium: "https://img2.doubanio.com/view/photo/m/public/p1.jpg?a=1&b=2",
+		Large:  "https://img2.doubanio.com/view/photo/l/public/p1.jpg",
+	}}
+	show := MovieInfo{
+		SID:      "26794435",
+		Name:     "请回答1988",
+		Rating:   "9.7",
+		Year:     "2015",
+		Actor:    "李惠利 / 柳俊烈",
+		Genre:    "剧情 / 喜剧 / 家庭",
+		Episodes: "20",
+	}
+
+	tests := []struct {
+		golden      string
+		info        MovieInfo
+		kind        string
+		celebrities []Celebrity
+		photos      []Photo
+	}{
+		{"movie.nfo.golden", film, "", celebrities, photos},
+		{"tvshow.nfo.golden", show, "", nil, nil},
+		{"movie_actor_fallback.nfo.golden", film, NFOMovie, celebrities[:1], nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			got := renderNFO(tt.info, nfoKind(tt.info, tt.kind), tt.celebrities, tt.photos)
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("output mismatch (run with -update to accept):\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestNFOKind(t *testing.T) {
+	tests := []struct {
+		episodes, kind, want string
+	}{
+		{"", "", NFOMovie},
+		{"12", "", NFOTVShow},
+		{"12", NFOMovie, NFOMovie},
+		{"", NFOTVShow, NFOTVShow},
+	}
+	for _, tt := range tests {
+		if got := nfoKind(MovieInfo{Episodes: tt.episodes}, tt.kind); got != tt.want {
+			t.Errorf("nfoKind(episodes=%q, %q) = %q, want %q", tt.episodes, tt.kind, got, tt.want)
+		}
+	}
+}
@@ -125,6 +125,10 @@ func (p *parser) parseMovieInfo(doc *goquery.Document, sid, imageSize string) Mo
 	if rating == "" {
 		rating = "0"
 	}
+	votes := strings.TrimSpace(content.Find(`span[property="v:votes"]`).Text())
+	if votes == "" {
+		votes = "0"
+	}
 	img := p.getImgBySize(attrOrEmpty(content.Find("a.nbgnbg>img"), "src"), imageSize)
 	intro := strings.TrimSpace(strings.ReplaceAll(content.Find("div.indent>span").Text(), "©豆瓣", ""))
 	infoText := p.parseInfoText(content.Find("#info"))
@@ -164,6 +168,7 @@ func (p *parser) parseMovieInfo(doc *goquery.Document, sid, imageSize string) Mo
 		Name:         name,
 		OriginalName: originalName,
 		Rating:       rating,
+		Votes:        votes,
 		Img:          img,
 		Year:         year,
 		Intro:        intro,
@@ -2,31 +2,42 @@ package movie
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/hashicorp/golang-lru/v2/expirable"
 
 	"github.com/haigeek/douban-api-go/internal/httpclient"
+	"github.com/haigeek/douban-api-go/internal/store"
 )
 
-const cacheSize = 100
+const (
+	cacheSize          = 100
+	imdbCandidateLimit = 3
+)
+
+var ErrNotFound = errors.New("subject not found")
 
 type Service struct {
 	client     *httpclient.Client
 	parser     *parser
 	movieCache *expirable.LRU[string, MovieInfo]
 	photoCache *expirable.LRU[string, []Photo]
+	imdbStore  *store.Store
 }
 
-func NewService(client *httpclient.Client) *Service {
+func NewService(client *httpclient.Client, imdbStore *store.Store) *Service {
 	return &Service{
 		client:     client,
 		parser:     newParser(),
+		imdbStore:  imdbStore,
 		movieCache: expirable.NewLRU[string, MovieInfo](cacheSize, nil, 1*time.Minute),
 		photoCache: expirable.NewLRU[string, []Photo](cacheSize, nil, 1*time.Minute),
 	}
@@ -78,6 +89,41 @@ func (s *Service) GetMovieInfo(ctx context.Context, sid, imageSize string) (Movi
 	return info, nil
 }
 
+func (s *Service) GetMovieInfoByIMDB(ctx context.Context, imdbID, imageSize string) (MovieInfo, error) {
+	if sid, ok := s.imdbStore.Get(imdbID); ok {
+		return s.GetMovieInfo(ctx, sid, imageSize)
+	}
+
+	movies, err := s.Search(ctx, imdbID, imdbCandidateLimit, imageSize)
+	if err != nil {
+		return MovieInfo{}, err
+	}
+	var lastErr error
+	fetched := 0
+	for _, m := range movies {
+		info, infoErr := s.GetMovieInfo(ctx, m.SID, imageSize)
+		if infoErr != nil {
+			if ctx.Err() != nil {
+				return MovieInfo{}, ctx.Err()
+			}
+			lastErr = infoErr
+			continue
+		}
+		fetched++
+		if !strings.EqualFold(info.IMDB, imdbID) {
+			continue
+		}
+		if err := s.imdbStore.Set(imdbID, info.SID); err != nil {
+			log.Printf("save imdb mapping %s -> %s failed: %v", imdbID, info.SID, err)
+		}
+		return info, nil
+	}
+	if fetched == 0 && lastErr != nil {
+		return MovieInfo{}, lastErr
+	}
+	return MovieInfo{}, ErrNotFound
+}
+
 func (s *Service) GetCelebrities(ctx context.Context, sid string) ([]Celebrity, error) {
 	doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://movie.douban.com/subject/%s/celebrities", sid), nil)
 	if err != nil {
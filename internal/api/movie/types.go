@@ -14,6 +14,7 @@ type MovieInfo struct {
 	Name         string      `json:"name"`
 	OriginalName string      `json:"originalName"`
 	Rating       string      `json:"rating"`
+	Votes        string      `json:"votes"`
 	Img          string      `json:"img"`
 	Year         string      `json:"year"`
 	Intro        string      `json:"intro"`
@@ -64,3 +65,33 @@ type Photo struct {
 	Width  string `json:"width"`
 	Height string `json:"height"`
 }
+
+type ParsedName struct {
+	Raw          string   `json:"raw"`
+	Title        string   `json:"title"`
+	LocalTitle   string   `json:"localTitle,omitempty"`
+	ForeignTitle string   `json:"foreignTitle,omitempty"`
+	Year         string   `json:"year,omitempty"`
+	Season       int      `json:"season,omitempty"`
+	Episode      int      `json:"episode,omitempty"`
+	Resolution   string   `json:"resolution,omitempty"`
+	Tags         []string `json:"tags"`
+}
+
+type MatchCandidate struct {
+	SID          string  `json:"sid"`
+	Cat          string  `json:"cat"`
+	Name         string  `json:"name"`
+	OriginalName string  `json:"originalName"`
+	Year         string  `json:"year"`
+	Rating       string  `json:"rating"`
+	Img          string  `json:"img"`
+	Score        float64 `json:"score"`
+}
+
+type MatchResult struct {
+	Query        ParsedName       `json:"query"`
+	Best         *MatchCandidate  `json:"best"`
+	Confidence   float64          `json:"confidence"`
+	Alternatives []MatchCandidate `json:"alternatives"`
+}
@@ -0,0 +1,61 @@
+package book
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	BatchTypeISBN = "isbn"
+	BatchTypeID   = "id"
+	maxBatchSize  = 500
+)
+
+func (s *Service) Batch(ctx context.Context, req BatchRequest, onResult func(BatchResult)) []BatchResult {
+	queries := make([]BatchResult, 0, len(req.ISBNs)+len(req.IDs))
+	for _, code := range req.ISBNs {
+		queries = append(queries, BatchResult{Index: len(queries), Type: BatchTypeISBN, Query: code})
+	}
+	for _, id := range req.IDs {
+		queries = append(queries, BatchResult{Index: len(queries), Type: BatchTypeID, Query: id})
+	}
+
+	results := make([]BatchResult, len(queries))
+	sem := make(chan struct{}, fetchConcurrency)
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, q := range queries {
+		wg.Add(1)
+		go func(q BatchResult) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var (
+				info DoubanBook
+				err  error
+			)
+			if q.Type == BatchTypeISBN {
+				info, err = s.GetBookInfoByISBN(ctx, q.Query)
+			} else {
+				info, err = s.GetBookInfo(ctx, q.Query)
+			}
+			if err != nil {
+				q.Error = err.Error()
+			} else {
+				q.Book = &info
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[q.Index] = q
+			if onResult != nil {
+				onResult(q)
+			}
+		}(q)
+	}
+	wg.Wait()
+	return results
+}
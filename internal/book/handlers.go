@@ -1,10 +1,17 @@
 package book
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/haigeek/douban-api-go/internal/isbn"
 )
 
 type Handlers struct {
@@ -22,10 +29,24 @@ func (h *Handlers) Search(c *gin.Context) {
 		return
 	}
 
+	start := 0
+	if raw, ok := c.GetQuery("start"); ok {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid start"})
+			return
+		}
+		start = v
+	}
+
 	count := 2
-	if raw, ok := c.GetQuery("count"); ok {
+	raw, ok := c.GetQuery("count")
+	if !ok {
+		raw, ok = c.GetQuery("limit")
+	}
+	if ok {
 		v, err := strconv.Atoi(raw)
-		if err != nil {
+		if err != nil || v < 0 {
 			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid count"})
 			return
 		}
@@ -37,8 +58,16 @@ func (h *Handlers) Search(c *gin.Context) {
 		return
 	}
 
-	result, err := h.service.Search(c.Request.Context(), q, count)
+	search := h.service.Search
+	if c.Query("type") == "full" {
+		search = h.service.SearchFull
+	}
+	result, err := search(c.Request.Context(), q, start, count)
 	if err != nil {
+		if errors.Is(err, ErrInvalidRange) {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
@@ -46,21 +75,166 @@ func (h *Handlers) Search(c *gin.Context) {
 }
 
 func (h *Handlers) ByID(c *gin.Context) {
-	sid := c.Param("sid")
+	sid, asOPF := strings.CutSuffix(c.Param("sid"), opfSuffix)
+	version, ok := opfVersion(c, asOPF)
+	if !ok {
+		return
+	}
 	info, err := h.service.GetBookInfo(c.Request.Context(), sid)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
+	if asOPF {
+		h.writeOPF(c, info, version)
+		return
+	}
 	c.JSON(http.StatusOK, info)
 }
 
 func (h *Handlers) ByISBN(c *gin.Context) {
-	isbn := c.Param("isbn")
-	info, err := h.service.GetBookInfoByISBN(c.Request.Context(), isbn)
+	code, asOPF := strings.CutSuffix(c.Param("isbn"), opfSuffix)
+	version, ok := opfVersion(c, asOPF)
+	if !ok {
+		return
+	}
+	info, err := h.service.GetBookInfoByISBN(c.Request.Context(), code)
 	if err != nil {
+		if errors.Is(err, isbn.ErrInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
+	if asOPF {
+		h.writeOPF(c, info, version)
+		return
+	}
 	c.JSON(http.StatusOK, info)
 }
+
+func opfVersion(c *gin.Context, asOPF bool) (string, bool) {
+	if !asOPF {
+		return "", true
+	}
+	switch c.DefaultQuery("version", "2") {
+	case "2", OPFVersion2:
+		return OPFVersion2, true
+	case "3", OPFVersion3:
+		return OPFVersion3, true
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"message": "invalid version"})
+	return "", false
+}
+
+func (h *Handlers) writeOPF(c *gin.Context, info DoubanBook, version string) {
+	body := OPF(info, version)
+	c.Data(http.StatusOK, "application/oebps-package+xml; charset=utf-8", body)
+}
+
+func (h *Handlers) Series(c *gin.Context) {
+	id := c.Param("id")
+	result, err := h.service.GetSeries(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) Editions(c *gin.Context) {
+	sid := c.Param("sid")
+	result, err := h.service.GetEditions(c.Request.Context(), sid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) Batch(c *gin.Context) {
+	var req BatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid request body"})
+		return
+	}
+	total := len(req.ISBNs) + len(req.IDs)
+	if total == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "isbns or ids is required"})
+		return
+	}
+	if total > maxBatchSize {
+		c.JSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("批量数量不能大于%d", maxBatchSize)})
+		return
+	}
+
+	stream := c.Query("stream") == "ndjson" || strings.Contains(c.GetHeader("Accept"), "application/x-ndjson")
+	if !stream {
+		results := h.service.Batch(c.Request.Context(), req, nil)
+		c.JSON(http.StatusOK, gin.H{"results": results})
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson; charset=utf-8")
+	c.Status(http.StatusOK)
+	enc := json.NewEncoder(c.Writer)
+	h.service.Batch(c.Request.Context(), req, func(result BatchResult) {
+		_ = enc.Encode(result)
+		c.Writer.Flush()
+	})
+}
+
+func (h *Handlers) Author(c *gin.Context) {
+	start := 0
+	if raw, ok := c.GetQuery("start"); ok {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid start"})
+			return
+		}
+		start = v
+	}
+
+	result, err := h.service.GetAuthor(c.Request.Context(), c.Param("id"), start)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) Comments(c *gin.Context) {
+	h.commentList(c, h.service.GetComments)
+}
+
+func (h *Handlers) Reviews(c *gin.Context) {
+	h.commentList(c, h.service.GetReviews)
+}
+
+func (h *Handlers) Annotations(c *gin.Context) {
+	h.commentList(c, h.service.GetAnnotations)
+}
+
+func (h *Handlers) commentList(c *gin.Context, fetch func(context.Context, string, string, int) (CommentList, error)) {
+	start := 0
+	if raw, ok := c.GetQuery("start"); ok {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid start"})
+			return
+		}
+		start = v
+	}
+
+	result, err := fetch(c.Request.Context(), c.Param("sid"), c.DefaultQuery("sort", "hot"), start)
+	if err != nil {
+		if errors.Is(err, ErrInvalidSort) {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
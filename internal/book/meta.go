@@ -0,0 +1,227 @@
+package book
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	PrecisionYear  = "year"
+	PrecisionMonth = "month"
+	PrecisionDay   = "day"
+
+	BindingPaperback = "paperback"
+	BindingHardcover = "hardcover"
+	BindingEbook     = "ebook"
+	BindingBoxed     = "boxed"
+	BindingThreadSew = "thread_sewn"
+	BindingOther     = "other"
+)
+
+var (
+	reMetaNumber = regexp.MustCompile(`[0-9]+(?:[.,][0-9]+)*`)
+	reMetaDate   = regexp.MustCompile(`([0-9]{4})(?:\s*[-./年]\s*([0-9]{1,2}))?(?:\s*[-./月]\s*([0-9]{1,2}))?`)
+
+	reVolumeOrdinal  = regexp.MustCompile(`第\s*([0-9]+|[零一二两三四五六七八九十百]+)\s*[卷册部辑集本季]`)
+	reVolumeLabel    = regexp.MustCompile(`(?i)\b(?:vol(?:ume)?|book|part|tome|no)\.?\s*([0-9]+)\b`)
+	reVolumeTrailing = regexp.MustCompile(`(?:^|[^0-9A-Za-z.,:])([0-9]{1,3})\s*[)）\]】]?\s*$`)
+)
+
+var currencyMarkers = []struct {
+	marker   string
+	currency string
+}{
+	{"NT$", "TWD"},
+	{"NTD", "TWD"},
+	{"TWD", "TWD"},
+	{"新台币", "TWD"},
+	{"台币", "TWD"},
+	{"台幣", "TWD"},
+	{"HK$", "HKD"},
+	{"HKD", "HKD"},
+	{"港币", "HKD"},
+	{"港元", "HKD"},
+	{"CA$", "CAD"},
+	{"C$", "CAD"},
+	{"CAD", "CAD"},
+	{"加元", "CAD"},
+	{"加币", "CAD"},
+	{"US$", "USD"},
+	{"USD", "USD"},
+	{"美元", "USD"},
+	{"$", "USD"},
+	{"JPY", "JPY"},
+	{"日元", "JPY"},
+	{"円", "JPY"},
+	{"EUR", "EUR"},
+	{"€", "EUR"},
+	{"欧元", "EUR"},
+	{"GBP", "GBP"},
+	{"£", "GBP"},
+	{"英镑", "GBP"},
+	{"CNY", "CNY"},
+	{"RMB", "CNY"},
+	{"元", "CNY"},
+	{"¥", "CNY"},
+	{"￥", "CNY"},
+}
+
+var bindingKeywords = []struct {
+	keyword string
+	binding string
+}{
+	{"电子", BindingEbook},
+	{"kindle", BindingEbook},
+	{"ebook", BindingEbook},
+	{"精装", BindingHardcover},
+	{"硬", BindingHardcover},
+	{"hardcover", BindingHardcover},
+	{"hardback", BindingHardcover},
+	{"盒装", BindingBoxed},
+	{"套装", BindingBoxed},
+	{"boxed", BindingBoxed},
+	{"线装", BindingThreadSew},
+	{"平装", BindingPaperback},
+	{"简装", BindingPaperback},
+	{"软", BindingPaperback},
+	{"胶订", BindingPaperback},
+	{"paperback", BindingPaperback},
+	{"mass market", BindingPaperback},
+}
+
+func parsePageCount(raw string) int {
+	n, err := strconv.Atoi(strings.ReplaceAll(reMetaNumber.FindString(raw), ",", ""))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func parsePrice(raw string) *Price {
+	raw = strings.TrimSpace(raw)
+	number := reMetaNumber.FindString(raw)
+	if number == "" {
+		return nil
+	}
+	amount, err := parseAmount(number)
+	if err != nil {
+		return nil
+	}
+
+	currency := "CNY"
+	upper := strings.ToUpper(raw)
+	for _, m := range currencyMarkers {
+		if strings.Contains(upper, m.marker) {
+			currency = m.currency
+			break
+		}
+	}
+	return &Price{Amount: amount, Currency: currency}
+}
+
+func parseAmount(number string) (float64, error) {
+	lastComma := strings.LastIndex(number, ",")
+	lastDot := strings.LastIndex(number, ".")
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		if lastComma > lastDot {
+			number = strings.ReplaceAll(number, ".", "")
+			number = strings.Replace(number, ",", ".", 1)
+		} else {
+			number = strings.ReplaceAll(number, ",", "")
+		}
+	case lastComma >= 0:
+		if strings.Count(number, ",") == 1 && len(number)-lastComma-1 != 3 {
+			number = strings.Replace(number, ",", ".", 1)
+		} else {
+			number = strings.ReplaceAll(number, ",", "")
+		}
+	case strings.Count(number, ".") > 1:
+		number = strings.ReplaceAll(number, ".", "")
+	}
+	return strconv.ParseFloat(number, 64)
+}
+
+func parsePartialDate(raw string) *PartialDate {
+	m := reMetaDate.FindStringSubmatch(raw)
+	if len(m) < 4 {
+		return nil
+	}
+	date := &PartialDate{Precision: PrecisionYear}
+	date.Year, _ = strconv.Atoi(m[1])
+	if month, err := strconv.Atoi(m[2]); err == nil && month >= 1 && month <= 12 {
+		date.Month = month
+		date.Precision = PrecisionMonth
+		if day, err := strconv.Atoi(m[3]); err == nil && day >= 1 && day <= 31 {
+			date.Day = day
+			date.Precision = PrecisionDay
+		}
+	}
+	return date
+}
+
+func (d PartialDate) String() string {
+	switch d.Precision {
+	case PrecisionDay:
+		return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	case PrecisionMonth:
+		return fmt.Sprintf("%04d-%02d", d.Year, d.Month)
+	default:
+		return fmt.Sprintf("%04d", d.Year)
+	}
+}
+
+func normalizeBinding(raw string) string {
+	lower := strings.ToLower(strings.TrimSpace(raw))
+	if lower == "" {
+		return ""
+	}
+	for _, k := range bindingKeywords {
+		if strings.Contains(lower, k.keyword) {
+			return k.binding
+		}
+	}
+	return BindingOther
+}
+
+func parseVolume(titles ...string) int {
+	for _, re := range []*regexp.Regexp{reVolumeOrdinal, reVolumeLabel, reVolumeTrailing} {
+		for _, title := range titles {
+			m := re.FindStringSubmatch(strings.TrimSpace(title))
+			if len(m) < 2 {
+				continue
+			}
+			if n := parseVolumeNumber(m[1]); n > 0 {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+func parseVolumeNumber(raw string) int {
+	if n, err := strconv.Atoi(raw); err == nil {
+		return n
+	}
+	digits := map[rune]int{'零': 0, '一': 1, '二': 2, '两': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9}
+	total, current := 0, 0
+	for _, r := range raw {
+		switch r {
+		case '百':
+			total += max(current, 1) * 100
+			current = 0
+		case '十':
+			total += max(current, 1) * 10
+			current = 0
+		default:
+			d, ok := digits[r]
+			if !ok {
+				return 0
+			}
+			current = d
+		}
+	}
+	return total + current
+}
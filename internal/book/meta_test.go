@@ -0,0 +1,132 @@
+package book
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want *Price
+	}{
+		{"45.00元", &Price{Amount: 45, Currency: "CNY"}},
+		{"CNY 59.80", &Price{Amount: 59.8, Currency: "CNY"}},
+		{"￥128", &Price{Amount: 128, Currency: "CNY"}},
+		{"NT$ 380", &Price{Amount: 380, Currency: "TWD"}},
+		{"nt$450", &Price{Amount: 450, Currency: "TWD"}},
+		{"TWD 320", &Price{Amount: 320, Currency: "TWD"}},
+		{"新台币 1,200", &Price{Amount: 1200, Currency: "TWD"}},
+		{"HK$98", &Price{Amount: 98, Currency: "HKD"}},
+		{"USD 16.99", &Price{Amount: 16.99, Currency: "USD"}},
+		{"$9.99", &Price{Amount: 9.99, Currency: "USD"}},
+		{"CAD 24.95", &Price{Amount: 24.95, Currency: "CAD"}},
+		{"C$ 32.00", &Price{Amount: 32, Currency: "CAD"}},
+		{"CA$19.99", &Price{Amount: 19.99, Currency: "CAD"}},
+		{"12,50 €", &Price{Amount: 12.5, Currency: "EUR"}},
+		{"EUR 1.234,56", &Price{Amount: 1234.56, Currency: "EUR"}},
+		{"£1,234.50", &Price{Amount: 1234.5, Currency: "GBP"}},
+		{"1,250円", &Price{Amount: 1250, Currency: "JPY"}},
+		{"JPY 1.500.000", &Price{Amount: 1500000, Currency: "JPY"}},
+		{"68", &Price{Amount: 68, Currency: "CNY"}},
+		{"", nil},
+		{"暂无", nil},
+	}
+	for _, tt := range tests {
+		if got := parsePrice(tt.raw); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePrice(%q) = %+v, want %+v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestParsePartialDate(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want *PartialDate
+	}{
+		{"2012-8-1", &PartialDate{Year: 2012, Month: 8, Day: 1, Precision: PrecisionDay}},
+		{"2012-08", &PartialDate{Year: 2012, Month: 8, Precision: PrecisionMonth}},
+		{"2012", &PartialDate{Year: 2012, Precision: PrecisionYear}},
+		{"2012年8月1日", &PartialDate{Year: 2012, Month: 8, Day: 1, Precision: PrecisionDay}},
+		{"2012年8月", &PartialDate{Year: 2012, Month: 8, Precision: PrecisionMonth}},
+		{"2012.8", &PartialDate{Year: 2012, Month: 8, Precision: PrecisionMonth}},
+		{"2012/08/15", &PartialDate{Year: 2012, Month: 8, Day: 15, Precision: PrecisionDay}},
+		{"2012-13", &PartialDate{Year: 2012, Precision: PrecisionYear}},
+		{"2012-8-40", &PartialDate{Year: 2012, Month: 8, Precision: PrecisionMonth}},
+		{"", nil},
+		{"不详", nil},
+	}
+	for _, tt := range tests {
+		if got := parsePartialDate(tt.raw); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePartialDate(%q) = %+v, want %+v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestPartialDateString(t *testing.T) {
+	tests := []struct {
+		date PartialDate
+		want string
+	}{
+		{PartialDate{Year: 2012, Month: 8, Day: 1, Precision: PrecisionDay}, "2012-08-01"},
+		{PartialDate{Year: 2012, Month: 8, Precision: PrecisionMonth}, "2012-08"},
+		{PartialDate{Year: 2012, Precision: PrecisionYear}, "2012"},
+	}
+	for _, tt := range tests {
+		if got := tt.date.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeBinding(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"平装", BindingPaperback},
+		{"简装本", BindingPaperback},
+		{"软精装", BindingHardcover},
+		{"精装", BindingHardcover},
+		{"Hardcover", BindingHardcover},
+		{" Paperback ", BindingPaperback},
+		{"Mass Market Paperback", BindingPaperback},
+		{"Kindle电子书", BindingEbook},
+		{"套装", BindingBoxed},
+		{"线装", BindingThreadSew},
+		{"锁线", BindingOther},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeBinding(tt.raw); got != tt.want {
+			t.Errorf("normalizeBinding(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestParseVolume(t *testing.T) {
+	tests := []struct {
+		titles []string
+		want   int
+	}{
+		{[]string{"三体Ⅱ", "黑暗森林"}, 0},
+		{[]string{"冰与火之歌（卷三）", "冰雨的风暴 第3卷"}, 3},
+		{[]string{"明朝那些事儿（第贰部）", ""}, 0},
+		{[]string{"明朝那些事儿", "第二部"}, 2},
+		{[]string{"史记 第十二册"}, 12},
+		{[]string{"资治通鉴 第一百二十卷"}, 120},
+		{[]string{"1Q84 BOOK 3"}, 3},
+		{[]string{"The Expanse, Vol. 4"}, 4},
+		{[]string{"哈利·波特（7）"}, 7},
+		{[]string{"鬼灭之刃 23"}, 23},
+		{[]string{"1984"}, 0},
+		{[]string{"Blade Runner 2049"}, 0},
+		{[]string{"罗马人的故事", "第1版"}, 0},
+		{nil, 0},
+	}
+	for _, tt := range tests {
+		if got := parseVolume(tt.titles...); got != tt.want {
+			t.Errorf("parseVolume(%q) = %d, want %d", tt.titles, got, tt.want)
+		}
+	}
+}
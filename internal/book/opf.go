@@ -0,0 +1,206 @@
+package book
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	OPFVersion2 = "2.0"
+	OPFVersion3 = "3.0"
+	opfSuffix   = ".opf"
+)
+
+var reOPFNationality = regexp.MustCompile(`^\s*[\[【(（][^\]】)）]*[\]】)）]\s*`)
+
+type opfContributor struct {
+	Name string
+	Role string
+}
+
+func OPF(info DoubanBook, version string) []byte {
+	if version == OPFVersion3 {
+		return renderOPF3(info, time.Now().UTC())
+	}
+	return renderOPF2(info)
+}
+
+func renderOPF2(info DoubanBook) []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<package xmlns="http://www.idpf.org/2007/opf" unique-identifier="douban_id" version="2.0">` + "\n")
+	b.WriteString(`  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:opf="http://www.idpf.org/2007/opf">` + "\n")
+
+	writeElem(&b, "dc:identifier", info.ID, "id", "douban_id", "opf:scheme", "DOUBAN")
+	if info.ISBN13 != "" {
+		writeElem(&b, "dc:identifier", info.ISBN13, "opf:scheme", "ISBN")
+	}
+	writeElem(&b, "dc:title", opfTitle(info))
+	for _, c := range opfContributors(info) {
+		tag := "dc:creator"
+		if c.Role != "aut" {
+			tag = "dc:contributor"
+		}
+		writeElem(&b, tag, c.Name, "opf:role", c.Role)
+	}
+	writeOPFCommon(&b, info)
+	if info.Serials != "" {
+		writeElem(&b, "meta", "", "name", "calibre:series", "content", info.Serials)
+		if volume := parseVolume(info.Title, info.Subtitle); volume > 0 {
+			writeElem(&b, "meta", "", "name", "calibre:series_index", "content", strconv.Itoa(volume))
+		}
+	}
+	if info.Rating.Average > 0 {
+		writeElem(&b, "meta", "", "name", "calibre:rating", "content", opfRating(info.Rating.Average))
+	}
+	b.WriteString("  </metadata>\n")
+
+	if cover := opfCover(info); cover != "" {
+		b.WriteString("  <guide>\n")
+		writeElem(&b, "reference", "", "type", "cover", "title", "Cover", "href", cover)
+		b.WriteString("  </guide>\n")
+	}
+	b.WriteString("</package>\n")
+	return b.Bytes()
+}
+
+func renderOPF3(info DoubanBook, modified time.Time) []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<package xmlns="http://www.idpf.org/2007/opf" unique-identifier="douban_id" version="3.0" prefix="calibre: https://calibre-ebook.com">` + "\n")
+	b.WriteString(`  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">` + "\n")
+
+	writeElem(&b, "dc:identifier", "douban:"+info.ID, "id", "douban_id")
+	if info.ISBN13 != "" {
+		writeElem(&b, "dc:identifier", "urn:isbn:"+info.ISBN13, "id", "isbn")
+	}
+	writeElem(&b, "dc:title", opfTitle(info))
+	for i, c := range opfContributors(info) {
+		tag := "dc:creator"
+		if c.Role != "aut" {
+			tag = "dc:contributor"
+		}
+		id := fmt.Sprintf("contributor%d", i+1)
+		writeElem(&b, tag, c.Name, "id", id)
+		writeElem(&b, "meta", c.Role, "refines", "#"+id, "property", "role", "scheme", "marc:relators")
+	}
+	writeOPFCommon(&b, info)
+	writeElem(&b, "meta", modified.Format("2006-01-02T15:04:05Z"), "property", "dcterms:modified")
+	if info.Serials != "" {
+		writeElem(&b, "meta", info.Serials, "property", "belongs-to-collection", "id", "series")
+		writeElem(&b, "meta", "series", "refines", "#series", "property", "collection-type")
+		if volume := parseVolume(info.Title, info.Subtitle); volume > 0 {
+			writeElem(&b, "meta", strconv.Itoa(volume), "refines", "#series", "property", "group-position")
+		}
+	}
+	if info.Rating.Average > 0 {
+		writeElem(&b, "meta", "", "name", "calibre:rating", "content", opfRating(info.Rating.Average))
+	}
+	b.WriteString("  </metadata>\n")
+
+	if cover := opfCover(info); cover != "" {
+		b.WriteString("  <manifest>\n")
+		writeElem(&b, "item", "", "id", "cover", "href", cover, "media-type", "image/jpeg", "properties", "cover-image")
+		b.WriteString("  </manifest>\n")
+	}
+	b.WriteString("</package>\n")
+	return b.Bytes()
+}
+
+func writeOPFCommon(b *bytes.Buffer, info DoubanBook) {
+	if info.Publisher != "" {
+		writeElem(b, "dc:publisher", info.Publisher)
+	}
+	if info.PubdateInfo != nil {
+		writeElem(b, "dc:date", info.PubdateInfo.String())
+	}
+	writeElem(b, "dc:language", opfLanguage(info))
+	if info.Summary != "" {
+		writeElem(b, "dc:description", info.Summary)
+	}
+	for _, tag := range info.Tags {
+		writeElem(b, "dc:subject", tag.Name)
+	}
+}
+
+func writeElem(b *bytes.Buffer, name, text string, attrs ...string) {
+	b.WriteString("    <" + name)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		b.WriteString(" " + attrs[i] + `="`)
+		_ = xml.EscapeText(b, []byte(attrs[i+1]))
+		b.WriteString(`"`)
+	}
+	if text == "" {
+		b.WriteString("/>\n")
+		return
+	}
+	b.WriteString(">")
+	_ = xml.EscapeText(b, []byte(text))
+	b.WriteString("</" + name + ">\n")
+}
+
+func opfTitle(info DoubanBook) string {
+	if info.Subtitle != "" {
+		return info.Title + "：" + info.Subtitle
+	}
+	return info.Title
+}
+
+func opfContributors(info DoubanBook) []opfContributor {
+	list := make([]opfContributor, 0, len(info.Author)+len(info.Translators))
+	for _, name := range info.Author {
+		if name = opfName(name); name != "" {
+			list = append(list, opfContributor{Name: name, Role: "aut"})
+		}
+	}
+	for _, name := range info.Translators {
+		if name = opfName(name); name != "" {
+			list = append(list, opfContributor{Name: name, Role: "trl"})
+		}
+	}
+	return list
+}
+
+func opfName(name string) string {
+	return strings.TrimSpace(reOPFNationality.ReplaceAllString(name, ""))
+}
+
+func opfLanguage(info DoubanBook) string {
+	var han, kana, hangul bool
+	for _, r := range info.Title + info.Subtitle {
+		switch {
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			kana = true
+		case unicode.Is(unicode.Hangul, r):
+			hangul = true
+		case unicode.Is(unicode.Han, r):
+			han = true
+		}
+	}
+	switch {
+	case kana:
+		return "ja"
+	case hangul:
+		return "ko"
+	case han:
+		return "zh"
+	}
+	return "und"
+}
+
+func opfCover(info DoubanBook) string {
+	if info.Images.Large != "" {
+		return info.Images.Large
+	}
+	return info.Images.Small
+}
+
+func opfRating(average float32) string {
+	return strconv.Itoa(int(average + 0.5))
+}
@@ -0,0 +1,78 @@
+package book
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func opfFixture() DoubanBook {
+	return DoubanBook{
+		ID:          "1003078",
+		Author:      []string{"[日] 村上春树", "（美）A & B"},
+		Translators: []string{"林少华", ""},
+		Images:      Image{Small: "https://img9.doubanio.com/view/subject/s/public/s1.jpg", Large: "https://img9.doubanio.com/view/subject/l/public/s1.jpg"},
+		Rating:      Rating{Average: 8.04},
+		ISBN13:      "9787532725694",
+		PubdateInfo: &PartialDate{Year: 2001, Month: 2, Precision: PrecisionMonth},
+		Publisher:   "上海译文出版社",
+		Serials:     "村上春树文集",
+		Subtitle:    "<新版> 第1卷",
+		Summary:     "关于\"青春\"的故事 & 回忆",
+		Title:       "挪威的森林",
+		Tags:        []Tag{{Name: "小说"}, {Name: "日本文学"}},
+	}
+}
+
+func TestRenderOPF(t *testing.T) {
+	modified := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	minimal := DoubanBook{ID: "42", Title: "Dune"}
+	tests := []struct {
+		golden string
+		got    []byte
+	}{
+		{"opf2.golden", renderOPF2(opfFixture())},
+		{"opf3.golden", renderOPF3(opfFixture(), modified)},
+		{"opf2_minimal.golden", renderOPF2(minimal)},
+		{"opf3_minimal.golden", renderOPF3(minimal, modified)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, tt.got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(tt.got) != string(want) {
+				t.Errorf("output mismatch (run with -update to accept):\n%s\nwant:\n%s", tt.got, want)
+			}
+		})
+	}
+}
+
+func TestOPFLanguage(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"挪威的森林", "zh"},
+		{"ノルウェイの森", "ja"},
+		{"상실의 시대", "ko"},
+		{"Dune", "und"},
+		{"", "und"},
+	}
+	for _, tt := range tests {
+		if got := opfLanguage(DoubanBook{Title: tt.title}); got != tt.want {
+			t.Errorf("opfLanguage(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
@@ -1,16 +1,31 @@
 package book
 
 import (
+	"html"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
+
+	"github.com/haigeek/douban-api-go/internal/isbn"
 )
 
 type parser struct {
 	reID               *regexp.Regexp
 	reInfoPair         *regexp.Regexp
 	reRemoveSplitSpace *regexp.Regexp
+	reStripTags        *regexp.Regexp
+	reSubjectID        *regexp.Regexp
+	reSeriesID         *regexp.Regexp
+	reWorksID          *regexp.Regexp
+	reTotal            *regexp.Regexp
+	rePeopleID         *regexp.Regexp
+	reAuthorID         *regexp.Regexp
+	reLifeDates        *regexp.Regexp
+	reStars            *regexp.Regexp
+	reDate             *regexp.Regexp
+	reAllTotal         *regexp.Regexp
 }
 
 func newParser() *parser {
@@ -18,6 +33,17 @@ func newParser() *parser {
 		reID:               regexp.MustCompile(`sid: ([0-9]+?),`),
 		reInfoPair:         regexp.MustCompile(`([^\s]+?):\s*([^\n]+)`),
 		reRemoveSplitSpace: regexp.MustCompile(`\s+?/\s+`),
+		reStripTags:        regexp.MustCompile(`(?s)<[^>]*>`),
+		reSubjectID:        regexp.MustCompile(`/subject/([0-9]+)`),
+		reSeriesID:         regexp.MustCompile(`/series/([0-9]+)`),
+		reWorksID:          regexp.MustCompile(`/works/([0-9]+)`),
+		reTotal:            regexp.MustCompile(`([0-9]+)`),
+		rePeopleID:         regexp.MustCompile(`/people/([^/]+)`),
+		reAuthorID:         regexp.MustCompile(`/author/([0-9]+)`),
+		reLifeDates:        regexp.MustCompile(`^(.+?)\s*至\s*(.+)$`),
+		reStars:            regexp.MustCompile(`allstar([0-9])0`),
+		reDate:             regexp.MustCompile(`([0-9]{4}-[0-9]{2}-[0-9]{2}(?: [0-9]{2}:[0-9]{2}(?::[0-9]{2})?)?)`),
+		reAllTotal:         regexp.MustCompile(`全部\D*?([0-9]+)`),
 	}
 }
 
@@ -44,6 +70,7 @@ func (p *parser) parseSearchList(doc *goquery.Document, count int) []DoubanBook
 		books = append(books, DoubanBook{
 			ID:          id,
 			Author:      author,
+			Authors:     []AuthorRef{},
 			AuthorIntro: "",
 			Translators: []string{},
 			Images: Image{
@@ -51,21 +78,28 @@ func (p *parser) parseSearchList(doc *goquery.Document, count int) []DoubanBook
 				Medium: "",
 				Small:  "",
 			},
-			Binding:   "",
-			Category:  "",
-			Rating:    Rating{Average: avg},
-			ISBN13:    "",
-			Pages:     "",
-			Price:     "",
-			Pubdate:   pubdate,
-			Publisher: publisher,
-			Producer:  "",
-			Serials:   "",
-			Subtitle:  "",
-			Summary:   summary,
-			Title:     title,
-			Tags:      []Tag{},
-			Origin:    "",
+			Binding:     "",
+			BindingType: "",
+			Category:    "",
+			Rating:      Rating{Average: avg},
+			ISBN13:      "",
+			Pages:       "",
+			PageCount:   0,
+			Price:       "",
+			PriceInfo:   nil,
+			Pubdate:     pubdate,
+			PubdateInfo: parsePartialDate(pubdate),
+			Publisher:   publisher,
+			Producer:    "",
+			Serials:     "",
+			SeriesID:    "",
+			WorksID:     "",
+			Subtitle:    "",
+			Summary:     summary,
+			Catalog:     "",
+			Title:       title,
+			Tags:        []Tag{},
+			Origin:      "",
 		})
 	})
 
@@ -111,6 +145,10 @@ func (p *parser) parseBookPage(doc *goquery.Document, id string) DoubanBook {
 
 	infoText := strings.TrimSpace(content.Find("#info").Text())
 	infoMap := p.parseInfoText(infoText)
+	authors := p.parseAuthorRefs(content.Find("#info"))
+	seriesID := captureGroup(p.reSeriesID, attrOrEmpty(content.Find(`#info a[href*="/series/"]`).First(), "href"))
+	worksID := captureGroup(p.reWorksID, attrOrEmpty(content.Find(`a[href*="/works/"]`).First(), "href"))
+	catalog := p.parseCatalog(content.Find(`div.indent[id^="dir_"][id$="_full"]`).First())
 
 	author := p.getTexts(infoMap, "作者")
 	translators := p.getTexts(infoMap, "译者")
@@ -128,6 +166,7 @@ func (p *parser) parseBookPage(doc *goquery.Document, id string) DoubanBook {
 	return DoubanBook{
 		ID:          id,
 		Author:      author,
+		Authors:     authors,
 		AuthorIntro: authorIntro,
 		Translators: translators,
 		Images: Image{
@@ -135,22 +174,275 @@ func (p *parser) parseBookPage(doc *goquery.Document, id string) DoubanBook {
 			Medium: largeImg,
 			Large:  largeImg,
 		},
-		Binding:   binding,
-		Category:  "",
-		Rating:    rating,
-		ISBN13:    isbn13,
-		Pages:     pages,
-		Price:     price,
-		Pubdate:   pubdate,
-		Publisher: publisher,
-		Producer:  producer,
-		Serials:   serials,
-		Subtitle:  subtitle,
-		Summary:   summary,
-		Title:     title,
-		Tags:      tags,
-		Origin:    origin,
+		Binding:     binding,
+		BindingType: normalizeBinding(binding),
+		Category:    "",
+		Rating:      rating,
+		ISBN13:      isbn13,
+		Pages:       pages,
+		PageCount:   parsePageCount(pages),
+		Price:       price,
+		PriceInfo:   parsePrice(price),
+		Pubdate:     pubdate,
+		PubdateInfo: parsePartialDate(pubdate),
+		Publisher:   publisher,
+		Producer:    producer,
+		Serials:     serials,
+		SeriesID:    seriesID,
+		WorksID:     worksID,
+		Subtitle:    subtitle,
+		Summary:     summary,
+		Catalog:     catalog,
+		Title:       title,
+		Tags:        tags,
+		Origin:      origin,
+	}
+}
+
+func (p *parser) parseAuthorRefs(info *goquery.Selection) []AuthorRef {
+	refs := make([]AuthorRef, 0)
+	info.Find("span.pl").Each(func(_ int, label *goquery.Selection) {
+		if strings.Trim(strings.TrimSpace(label.Text()), ":：") != "作者" {
+			return
+		}
+		links := label.Parent().Find("a")
+		if label.Parent().Is("#info") {
+			links = label.NextUntil("br").Filter("a")
+		}
+		links.Each(func(_ int, a *goquery.Selection) {
+			id := captureGroup(p.reAuthorID, attrOrEmpty(a, "href"))
+			if id == "" {
+				return
+			}
+			refs = append(refs, AuthorRef{ID: id, Name: strings.Join(strings.Fields(a.Text()), " ")})
+		})
+	})
+	return refs
+}
+
+func (p *parser) parseAuthorPage(doc *goquery.Document, id string) Author {
+	content := doc.Find("#content")
+	headline := content.Find("#headline")
+
+	intro := strings.TrimSpace(content.Find("#intro .bd .all").Text())
+	if intro == "" {
+		intro = strings.TrimSpace(content.Find("#intro .bd").Text())
+	}
+
+	author := Author{
+		ID:    id,
+		Name:  strings.TrimSpace(content.Find("h1").First().Text()),
+		Photo: attrOrEmpty(headline.Find(".pic img").First(), "src"),
+		Intro: intro,
 	}
+	headline.Find(".info li").Each(func(_ int, li *goquery.Selection) {
+		key, value, ok := strings.Cut(strings.Join(strings.Fields(li.Text()), " "), ":")
+		if !ok {
+			return
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "性别":
+			author.Gender = value
+		case "出生日期":
+			author.Birthdate = value
+		case "生卒日期":
+			if m := p.reLifeDates.FindStringSubmatch(value); len(m) == 3 {
+				author.Birthdate = strings.TrimSpace(m[1])
+				author.Deathdate = strings.TrimSpace(m[2])
+			} else {
+				author.Birthdate = value
+			}
+		case "出生地":
+			author.Birthplace = value
+		case "国家/地区":
+			author.Nationality = value
+		}
+	})
+	return author
+}
+
+func (p *parser) parseAuthorWorks(doc *goquery.Document) ([]BookItem, int) {
+	content := doc.Find("#content")
+	books := p.parseSubjectItems(content)
+	total, _ := strconv.Atoi(captureGroup(p.reTotal, content.Find("span.count").First().Text()))
+	return books, total
+}
+
+func (p *parser) parseCatalog(sel *goquery.Selection) string {
+	if sel.Length() == 0 {
+		return ""
+	}
+	rawHTML, err := sel.Html()
+	if err != nil {
+		return ""
+	}
+
+	text := strings.NewReplacer("<br/>", "\n", "<br />", "\n", "<br>", "\n").Replace(rawHTML)
+	text = html.UnescapeString(p.reStripTags.ReplaceAllString(text, ""))
+	lines := make([]string, 0)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "· · ·") || strings.Contains(line, "(收起)") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *parser) parseSeriesPage(doc *goquery.Document) (string, int, []BookItem, bool) {
+	content := doc.Find("#content")
+	title := strings.TrimSpace(content.Find("h1").First().Text())
+	total, _ := strconv.Atoi(captureGroup(p.reTotal, content.Find("div.pl2").First().Text()))
+
+	books := p.parseSubjectItems(content)
+	hasNext := content.Find("div.paginator span.next a").Length() > 0
+	return title, total, books, hasNext
+}
+
+func (p *parser) parseSubjectItems(sel *goquery.Selection) []BookItem {
+	books := make([]BookItem, 0)
+	sel.Find("li.subject-item").Each(func(_ int, li *goquery.Selection) {
+		link := li.Find("div.info h2 a").First()
+		title := attrOrEmpty(link, "title")
+		if title == "" {
+			title = strings.Join(strings.Fields(link.Text()), " ")
+		}
+
+		rating := Rating{Average: 0}
+		if avg, err := parseFloat32(strings.TrimSpace(li.Find(".rating_nums").Text())); err == nil {
+			rating.Average = avg
+		}
+
+		books = append(books, BookItem{
+			Volume: parseVolume(title),
+			ID:     captureGroup(p.reSubjectID, attrOrEmpty(link, "href")),
+			Title:  title,
+			Pub:    strings.TrimSpace(li.Find("div.pub").Text()),
+			Rating: rating,
+			Image:  attrOrEmpty(li.Find("div.pic img"), "src"),
+		})
+	})
+	return books
+}
+
+func (p *parser) parseWorksPage(doc *goquery.Document) []Edition {
+	editions := make([]Edition, 0)
+	seen := make(map[string]bool)
+	doc.Find(`#content .article a[href*="/subject/"]`).Each(func(_ int, a *goquery.Selection) {
+		id := captureGroup(p.reSubjectID, attrOrEmpty(a, "href"))
+		if id == "" || seen[id] {
+			return
+		}
+		seen[id] = true
+
+		block := a.Closest(".bkses, li, tr")
+		if block.Length() == 0 {
+			block = a.Parent()
+		}
+		info := p.parseInfoText(block.Text())
+		title := ""
+		block.Find(`a[href*="/subject/` + id + `"]`).EachWithBreak(func(_ int, link *goquery.Selection) bool {
+			title = strings.TrimSpace(link.Text())
+			return title == ""
+		})
+		edition := Edition{
+			ID:        id,
+			Title:     title,
+			Publisher: p.getText(info, "出版社"),
+			Pubdate:   p.getText(info, "出版年"),
+			Binding:   p.getText(info, "装帧"),
+			Image:     attrOrEmpty(block.Find("img").First(), "src"),
+		}
+		if canonical, err := isbn.Normalize(p.getText(info, "ISBN")); err == nil {
+			edition.ISBN13 = canonical
+		}
+		editions = append(editions, edition)
+	})
+	return editions
+}
+
+func (p *parser) parseComments(doc *goquery.Document) ([]Comment, int) {
+	comments := make([]Comment, 0)
+	doc.Find("li.comment-item").Each(func(_ int, li *goquery.Selection) {
+		info := li.Find("span.comment-info")
+		author := info.Find("a").First()
+		votes, _ := strconv.Atoi(strings.TrimSpace(li.Find("span.vote-count").First().Text()))
+		comments = append(comments, Comment{
+			ID:       attrOrEmpty(li, "data-cid"),
+			Author:   strings.TrimSpace(author.Text()),
+			AuthorID: captureGroup(p.rePeopleID, attrOrEmpty(author, "href")),
+			Rating:   p.parseStars(info.Find("span.user-stars")),
+			Date:     captureGroup(p.reDate, info.Find(".comment-time").Text()),
+			Votes:    votes,
+			Content:  strings.TrimSpace(li.Find("span.short").Text()),
+		})
+	})
+
+	total, _ := strconv.Atoi(strings.TrimSpace(doc.Find("#total-comments").Text()))
+	if total == 0 {
+		total, _ = strconv.Atoi(captureGroup(p.reAllTotal, doc.Find("#content .nav-tab").Text()))
+	}
+	return comments, total
+}
+
+func (p *parser) parseReviews(doc *goquery.Document) ([]Comment, int) {
+	reviews := make([]Comment, 0)
+	doc.Find("div.review-item").Each(func(_ int, item *goquery.Selection) {
+		header := item.Find("header")
+		author := header.Find("a.name").First()
+		link := item.Find("div.main-bd h2 a").First()
+		content := strings.TrimSpace(item.Find("div.short-content").Text())
+		content = strings.TrimSpace(strings.TrimSuffix(content, "(展开)"))
+		votes, _ := strconv.Atoi(strings.TrimSpace(item.Find("a.action-btn.up span").First().Text()))
+		reviews = append(reviews, Comment{
+			ID:       attrOrEmpty(item, "id"),
+			Author:   strings.TrimSpace(author.Text()),
+			AuthorID: captureGroup(p.rePeopleID, attrOrEmpty(author, "href")),
+			Rating:   p.parseStars(header.Find("span.main-title-rating")),
+			Date:     captureGroup(p.reDate, header.Find("span.main-meta").Text()),
+			Votes:    votes,
+			Title:    strings.TrimSpace(link.Text()),
+			Content:  content,
+			URL:      attrOrEmpty(link, "href"),
+		})
+	})
+
+	total, _ := strconv.Atoi(captureGroup(p.reTotal, doc.Find("#content h1").First().Text()))
+	return reviews, total
+}
+
+func (p *parser) parseAnnotations(doc *goquery.Document) ([]Comment, int) {
+	notes := make([]Comment, 0)
+	doc.Find("li.ctsh").Each(func(_ int, li *goquery.Selection) {
+		author := li.Find("div.ilst a").First()
+		link := li.Find("div.nlst h3 a").First()
+		content := strings.TrimSpace(li.Find("div.reading-note div.all").Text())
+		if content == "" {
+			content = strings.TrimSpace(li.Find("div.reading-note div.short").Text())
+		}
+		votes, _ := strconv.Atoi(strings.TrimSpace(li.Find("span.vote-count").First().Text()))
+		notes = append(notes, Comment{
+			ID:       attrOrEmpty(li, "data-cid"),
+			Author:   attrOrEmpty(author, "title"),
+			AuthorID: captureGroup(p.rePeopleID, attrOrEmpty(author, "href")),
+			Rating:   p.parseStars(li.Find("span[class*='allstar']")),
+			Date:     captureGroup(p.reDate, li.Text()),
+			Votes:    votes,
+			Title:    strings.TrimSpace(link.Text()),
+			Content:  content,
+			URL:      attrOrEmpty(link, "href"),
+		})
+	})
+
+	total, _ := strconv.Atoi(captureGroup(p.reTotal, doc.Find("#content h1").First().Text()))
+	return notes, total
+}
+
+func (p *parser) parseStars(sel *goquery.Selection) int {
+	n, _ := strconv.Atoi(captureGroup(p.reStars, attrOrEmpty(sel, "class")))
+	return n
 }
 
 func (p *parser) parseSubjectCast(text string) ([]string, string, string) {
@@ -216,6 +508,14 @@ func (p *parser) getTexts(info map[string]string, key string) []string {
 	return res
 }
 
+func attrOrEmpty(sel *goquery.Selection, key string) string {
+	v, ok := sel.Attr(key)
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(v)
+}
+
 func captureGroup(re *regexp.Regexp, text string) string {
 	m := re.FindStringSubmatch(text)
 	if len(m) < 2 {
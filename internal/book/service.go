@@ -2,35 +2,54 @@ package book
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/hashicorp/golang-lru/v2/expirable"
 
 	"github.com/haigeek/douban-api-go/internal/httpclient"
+	"github.com/haigeek/douban-api-go/internal/isbn"
 )
 
-const cacheSize = 100
+const (
+	commentPageSize  = 20
+	cacheSize        = 100
+	searchPageSize   = 20
+	fetchConcurrency = 4
+	maxSeriesPages   = 50
+	maxSearchPages   = 5
+)
 
 type Service struct {
-	client *httpclient.Client
-	parser *parser
-	cache  *expirable.LRU[string, DoubanBook]
+	client      *httpclient.Client
+	parser      *parser
+	cache       *expirable.LRU[string, DoubanBook]
+	seriesCache *expirable.LRU[string, Series]
 }
 
+var (
+	ErrInvalidSort  = errors.New("invalid sort")
+	ErrInvalidRange = errors.New("invalid start or count")
+)
+
 func NewService(client *httpclient.Client) *Service {
 	return &Service{
-		client: client,
-		parser: newParser(),
-		cache:  expirable.NewLRU[string, DoubanBook](cacheSize, nil, 10*time.Minute),
+		client:      client,
+		parser:      newParser(),
+		cache:       expirable.NewLRU[string, DoubanBook](cacheSize, nil, 10*time.Minute),
+		seriesCache: expirable.NewLRU[string, Series](cacheSize, nil, 1*time.Hour),
 	}
 }
 
-func (s *Service) Search(ctx context.Context, q string, count int) (DoubanBookResult, error) {
-	list, err := s.getList(ctx, q, count)
+func (s *Service) Search(ctx context.Context, q string, start, count int) (DoubanBookResult, error) {
+	list, err := s.getList(ctx, q, start, count)
 	if err != nil {
 		return DoubanBookResult{}, err
 	}
@@ -41,32 +60,148 @@ func (s *Service) Search(ctx context.Context, q string, count int) (DoubanBookRe
 	}, nil
 }
 
-func (s *Service) getList(ctx context.Context, q string, count int) ([]DoubanBook, error) {
+func (s *Service) SearchFull(ctx context.Context, q string, start, count int) (DoubanBookResult, error) {
+	list, err := s.getList(ctx, q, start, count)
+	if err != nil {
+		return DoubanBookResult{}, err
+	}
+
+	books := make([]DoubanBook, len(list))
+	errs := make([]error, len(list))
+	sem := make(chan struct{}, fetchConcurrency)
+	var wg sync.WaitGroup
+	for i, item := range list {
+		if item.ID == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			books[i], errs[i] = s.GetBookInfo(ctx, id)
+		}(i, item.ID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return DoubanBookResult{}, err
+		}
+	}
+	full := make([]DoubanBook, 0, len(books))
+	for i, b := range books {
+		if list[i].ID != "" {
+			full = append(full, b)
+		}
+	}
+	return DoubanBookResult{
+		Code:  0,
+		Msg:   "",
+		Books: full,
+	}, nil
+}
+
+func (s *Service) getList(ctx context.Context, q string, start, count int) ([]DoubanBook, error) {
+	if start < 0 || count < 0 {
+		return nil, ErrInvalidRange
+	}
 	if q == "" {
 		return []DoubanBook{}, nil
 	}
-	resp, err := s.client.Get(ctx, "https://www.douban.com/search", map[string]string{
-		"cat": "1001",
-		"q":   q,
+	return collectPages(start, count, func(page int) ([]DoubanBook, bool, error) {
+		return s.getSearchPage(ctx, q, page)
+	})
+}
+
+func collectPages(start, count int, fetch func(page int) ([]DoubanBook, bool, error)) ([]DoubanBook, error) {
+	books := make([]DoubanBook, 0)
+	offset := start % searchPageSize
+	page := start - offset
+	for i := 0; i < maxSearchPages; i++ {
+		list, more, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+		if len(list) == 0 {
+			break
+		}
+		if offset > 0 {
+			if offset >= len(list) {
+				list = list[:0]
+			} else {
+				list = list[offset:]
+			}
+			offset = 0
+		}
+		books = append(books, list...)
+		if count == 0 || len(books) >= count || !more {
+			break
+		}
+		page += searchPageSize
+	}
+
+	if count > 0 && len(books) > count {
+		return books[:count], nil
+	}
+	return books, nil
+}
+
+func (s *Service) getSearchPage(ctx context.Context, q string, start int) ([]DoubanBook, bool, error) {
+	if start == 0 {
+		resp, err := s.client.Get(ctx, "https://www.douban.com/search", map[string]string{
+			"cat": "1001",
+			"q":   q,
+		}, true)
+		if err != nil {
+			return nil, false, err
+		}
+		defer resp.Body.Close()
+
+		doc, err := goquery.NewDocumentFromReader(resp.Body)
+		if err != nil {
+			return nil, false, err
+		}
+		list := s.parser.parseSearchList(doc, 0)
+		return list, len(list) >= searchPageSize, nil
+	}
+
+	resp, err := s.client.Get(ctx, "https://www.douban.com/j/search", map[string]string{
+		"cat":   "1001",
+		"q":     q,
+		"start": strconv.Itoa(start),
 	}, true)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer resp.Body.Close()
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	var page searchPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, false, err
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="result-list">` + strings.Join(page.Items, "") + `</div>`))
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return s.parser.parseSearchList(doc, count), nil
+	return s.parser.parseSearchList(doc, 0), page.More, nil
 }
 
-func (s *Service) GetBookInfoByISBN(ctx context.Context, isbn string) (DoubanBook, error) {
-	if v, ok := s.cache.Get(isbn); ok {
+func (s *Service) GetBookInfoByISBN(ctx context.Context, code string) (DoubanBook, error) {
+	canonical, err := isbn.Normalize(code)
+	if err != nil {
+		return DoubanBook{}, err
+	}
+	if v, ok := s.cache.Get(canonical); ok {
 		return v, nil
 	}
-	url := fmt.Sprintf("https://douban.com/isbn/%s/", isbn)
-	return s.getBookInternal(ctx, url)
+	url := fmt.Sprintf("https://douban.com/isbn/%s/", canonical)
+	info, err := s.getBookInternal(ctx, url)
+	if err != nil {
+		return DoubanBook{}, err
+	}
+	s.cache.Add(canonical, info)
+	return info, nil
 }
 
 func (s *Service) GetBookInfo(ctx context.Context, id string) (DoubanBook, error) {
@@ -77,6 +212,204 @@ func (s *Service) GetBookInfo(ctx context.Context, id string) (DoubanBook, error
 	return s.getBookInternal(ctx, url)
 }
 
+func (s *Service) GetSeries(ctx context.Context, id string) (Series, error) {
+	if v, ok := s.seriesCache.Get(id); ok {
+		return v, nil
+	}
+
+	series := Series{ID: id, Books: make([]BookItem, 0)}
+	for page := 1; page <= maxSeriesPages; page++ {
+		doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/series/%s", id), map[string]string{
+			"page": strconv.Itoa(page),
+		})
+		if err != nil {
+			return Series{}, err
+		}
+		title, total, books, hasNext := s.parser.parseSeriesPage(doc)
+		if page == 1 {
+			series.Title = title
+			series.Total = total
+		}
+		for _, b := range books {
+			b.Position = len(series.Books) + 1
+			series.Books = append(series.Books, b)
+		}
+		if !hasNext || len(books) == 0 {
+			break
+		}
+	}
+	if series.Total == 0 {
+		series.Total = len(series.Books)
+	}
+
+	s.seriesCache.Add(id, series)
+	return series, nil
+}
+
+func (s *Service) GetEditions(ctx context.Context, id string) ([]Edition, error) {
+	info, err := s.GetBookInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if info.WorksID == "" {
+		return []Edition{}, nil
+	}
+
+	doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/works/%s", info.WorksID), nil)
+	if err != nil {
+		return nil, err
+	}
+	editions := make([]Edition, 0)
+	for _, edition := range s.parser.parseWorksPage(doc) {
+		if edition.ID != info.ID {
+			editions = append(editions, edition)
+		}
+	}
+
+	sem := make(chan struct{}, fetchConcurrency)
+	var wg sync.WaitGroup
+	for i := range editions {
+		if editionComplete(editions[i]) {
+			continue
+		}
+		wg.Add(1)
+		go func(edition *Edition) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			book, err := s.GetBookInfo(ctx, edition.ID)
+			if err != nil {
+				log.Printf("fetch edition %s failed: %v", edition.ID, err)
+				return
+			}
+			*edition = Edition{
+				ID:        book.ID,
+				Title:     book.Title,
+				Subtitle:  book.Subtitle,
+				ISBN13:    book.ISBN13,
+				Publisher: book.Publisher,
+				Pubdate:   book.Pubdate,
+				Binding:   book.Binding,
+				Image:     book.Images.Small,
+			}
+		}(&editions[i])
+	}
+	wg.Wait()
+	return editions, nil
+}
+
+func editionComplete(e Edition) bool {
+	return e.Title != "" && e.Publisher != "" && e.Pubdate != "" && e.ISBN13 != ""
+}
+
+func (s *Service) GetAuthor(ctx context.Context, id string, start int) (Author, error) {
+	doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/author/%s/", id), nil)
+	if err != nil {
+		return Author{}, err
+	}
+	author := s.parser.parseAuthorPage(doc, id)
+
+	worksDoc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/author/%s/books", id), map[string]string{
+		"start":  strconv.Itoa(start),
+		"sortby": "time",
+	})
+	if err != nil {
+		return Author{}, err
+	}
+	books, total := s.parser.parseAuthorWorks(worksDoc)
+	for i := range books {
+		books[i].Position = start + i + 1
+	}
+	if total < start+len(books) {
+		total = start + len(books)
+	}
+	author.Works = AuthorWorks{
+		Start: start,
+		Count: len(books),
+		Total: total,
+		Books: books,
+	}
+	return author, nil
+}
+
+var (
+	commentSorts    = map[string]string{"hot": "new_score", "time": "time"}
+	reviewSorts     = map[string]string{"hot": "hotest", "time": "time"}
+	annotationSorts = map[string]string{"hot": "rank", "page": "page", "time": "time"}
+)
+
+func (s *Service) GetComments(ctx context.Context, id, sort string, start int) (CommentList, error) {
+	upstreamSort, ok := commentSorts[sort]
+	if !ok {
+		return CommentList{}, ErrInvalidSort
+	}
+	doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/subject/%s/comments/", id), map[string]string{
+		"start":  strconv.Itoa(start),
+		"limit":  strconv.Itoa(commentPageSize),
+		"status": "P",
+		"sort":   upstreamSort,
+	})
+	if err != nil {
+		return CommentList{}, err
+	}
+	comments, total := s.parser.parseComments(doc)
+	return newCommentList(start, total, comments), nil
+}
+
+func (s *Service) GetReviews(ctx context.Context, id, sort string, start int) (CommentList, error) {
+	upstreamSort, ok := reviewSorts[sort]
+	if !ok {
+		return CommentList{}, ErrInvalidSort
+	}
+	doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/subject/%s/reviews", id), map[string]string{
+		"start": strconv.Itoa(start),
+		"sort":  upstreamSort,
+	})
+	if err != nil {
+		return CommentList{}, err
+	}
+	reviews, total := s.parser.parseReviews(doc)
+	return newCommentList(start, total, reviews), nil
+}
+
+func (s *Service) GetAnnotations(ctx context.Context, id, sort string, start int) (CommentList, error) {
+	upstreamSort, ok := annotationSorts[sort]
+	if !ok {
+		return CommentList{}, ErrInvalidSort
+	}
+	doc, err := s.fetchDocument(ctx, fmt.Sprintf("https://book.douban.com/subject/%s/annotation", id), map[string]string{
+		"start": strconv.Itoa(start),
+		"sort":  upstreamSort,
+	})
+	if err != nil {
+		return CommentList{}, err
+	}
+	notes, total := s.parser.parseAnnotations(doc)
+	return newCommentList(start, total, notes), nil
+}
+
+func newCommentList(start, total int, comments []Comment) CommentList {
+	if total < start+len(comments) {
+		total = start + len(comments)
+	}
+	return CommentList{
+		Start:    start,
+		Count:    len(comments),
+		Total:    total,
+		Comments: comments,
+	}
+}
+
+func (s *Service) fetchDocument(ctx context.Context, rawURL string, query map[string]string) (*goquery.Document, error) {
+	resp, err := s.client.Get(ctx, rawURL, query, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return goquery.NewDocumentFromReader(resp.Body)
+}
+
 func (s *Service) getBookInternal(ctx context.Context, rawURL string) (DoubanBook, error) {
 	resp, err := s.client.Get(ctx, rawURL, nil, true)
 	if err != nil {
@@ -103,8 +436,8 @@ func (s *Service) getBookInternal(ctx context.Context, rawURL string) (DoubanBoo
 
 	info := s.parser.parseBookPage(doc, finalID)
 	s.cache.Add(finalID, info)
-	if info.ISBN13 != "" {
-		s.cache.Add(info.ISBN13, info)
+	if canonical, err := isbn.Normalize(info.ISBN13); err == nil {
+		s.cache.Add(canonical, info)
 	}
 
 	return info, nil
@@ -0,0 +1,68 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func fakePages(total int, more bool) (func(page int) ([]DoubanBook, bool, error), *[]int) {
+	var pages []int
+	return func(page int) ([]DoubanBook, bool, error) {
+		pages = append(pages, page)
+		list := make([]DoubanBook, 0, searchPageSize)
+		for i := page; i < page+searchPageSize && i < total; i++ {
+			list = append(list, DoubanBook{ID: fmt.Sprint(i)})
+		}
+		return list, more, nil
+	}, &pages
+}
+
+func TestCollectPages(t *testing.T) {
+	tests := []struct {
+		name        string
+		total       int
+		more        bool
+		start       int
+		count       int
+		wantFirst   string
+		wantLen     int
+		wantFetches int
+	}{
+		{"single page default", 100, true, 0, 0, "0", 20, 1},
+		{"count within page", 100, true, 0, 5, "0", 5, 1},
+		{"offset spans pages", 100, true, 15, 10, "15", 10, 2},
+		{"offset past short page", 10, true, 15, 10, "", 0, 2},
+		{"stops on empty page despite more", 30, true, 0, 100, "0", 30, 3},
+		{"stops when more is false", 100, false, 0, 100, "0", 20, 1},
+		{"bounded by max pages", 1000, true, 0, 1000, "0", maxSearchPages * searchPageSize, maxSearchPages},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch, pages := fakePages(tt.total, tt.more)
+			books, err := collectPages(tt.start, tt.count, fetch)
+			if err != nil {
+				t.Fatalf("collectPages: %v", err)
+			}
+			if len(books) != tt.wantLen {
+				t.Errorf("len = %d, want %d", len(books), tt.wantLen)
+			}
+			if len(books) > 0 && books[0].ID != tt.wantFirst {
+				t.Errorf("first = %s, want %s", books[0].ID, tt.wantFirst)
+			}
+			if len(*pages) != tt.wantFetches {
+				t.Errorf("fetched pages %v, want %d fetches", *pages, tt.wantFetches)
+			}
+		})
+	}
+}
+
+func TestGetListRejectsNegativeRange(t *testing.T) {
+	s := &Service{}
+	for _, r := range [][2]int{{-1, 2}, {0, -1}} {
+		if _, err := s.getList(context.Background(), "q", r[0], r[1]); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("getList(start=%d, count=%d) = %v, want ErrInvalidRange", r[0], r[1], err)
+		}
+	}
+}
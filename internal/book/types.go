@@ -7,26 +7,46 @@ type DoubanBookResult struct {
 }
 
 type DoubanBook struct {
-	ID          string   `json:"id"`
-	Author      []string `json:"author"`
-	AuthorIntro string   `json:"author_intro"`
-	Translators []string `json:"translators"`
-	Images      Image    `json:"images"`
-	Binding     string   `json:"binding"`
-	Category    string   `json:"category"`
-	Rating      Rating   `json:"rating"`
-	ISBN13      string   `json:"isbn13"`
-	Pages       string   `json:"pages"`
-	Price       string   `json:"price"`
-	Pubdate     string   `json:"pubdate"`
-	Publisher   string   `json:"publisher"`
-	Producer    string   `json:"producer"`
-	Serials     string   `json:"serials"`
-	Subtitle    string   `json:"subtitle"`
-	Summary     string   `json:"summary"`
-	Title       string   `json:"title"`
-	Tags        []Tag    `json:"tags"`
-	Origin      string   `json:"origin"`
+	ID          string       `json:"id"`
+	Author      []string     `json:"author"`
+	Authors     []AuthorRef  `json:"authors"`
+	AuthorIntro string       `json:"author_intro"`
+	Translators []string     `json:"translators"`
+	Images      Image        `json:"images"`
+	Binding     string       `json:"binding"`
+	Category    string       `json:"category"`
+	Rating      Rating       `json:"rating"`
+	ISBN13      string       `json:"isbn13"`
+	Pages       string       `json:"pages"`
+	PageCount   int          `json:"page_count"`
+	Price       string       `json:"price"`
+	PriceInfo   *Price       `json:"price_info"`
+	Pubdate     string       `json:"pubdate"`
+	PubdateInfo *PartialDate `json:"pubdate_info"`
+	BindingType string       `json:"binding_type"`
+	Publisher   string       `json:"publisher"`
+	Producer    string       `json:"producer"`
+	Serials     string       `json:"serials"`
+	SeriesID    string       `json:"series_id"`
+	WorksID     string       `json:"works_id"`
+	Subtitle    string       `json:"subtitle"`
+	Summary     string       `json:"summary"`
+	Catalog     string       `json:"catalog"`
+	Title       string       `json:"title"`
+	Tags        []Tag        `json:"tags"`
+	Origin      string       `json:"origin"`
+}
+
+type Price struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+type PartialDate struct {
+	Year      int    `json:"year"`
+	Month     int    `json:"month,omitempty"`
+	Day       int    `json:"day,omitempty"`
+	Precision string `json:"precision"`
 }
 
 type Image struct {
@@ -42,3 +62,94 @@ type Tag struct {
 type Rating struct {
 	Average float32 `json:"average"`
 }
+
+type searchPage struct {
+	Items []string `json:"items"`
+	More  bool     `json:"more"`
+	Total int      `json:"total"`
+}
+
+type Series struct {
+	ID    string     `json:"id"`
+	Title string     `json:"title"`
+	Total int        `json:"total"`
+	Books []BookItem `json:"books"`
+}
+
+type BookItem struct {
+	Position int    `json:"position"`
+	Volume   int    `json:"volume,omitempty"`
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Pub      string `json:"pub"`
+	Rating   Rating `json:"rating"`
+	Image    string `json:"image"`
+}
+
+type Edition struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Subtitle  string `json:"subtitle"`
+	ISBN13    string `json:"isbn13"`
+	Publisher string `json:"publisher"`
+	Pubdate   string `json:"pubdate"`
+	Binding   string `json:"binding"`
+	Image     string `json:"image"`
+}
+
+type BatchRequest struct {
+	ISBNs []string `json:"isbns"`
+	IDs   []string `json:"ids"`
+}
+
+type BatchResult struct {
+	Index int         `json:"index"`
+	Type  string      `json:"type"`
+	Query string      `json:"query"`
+	Book  *DoubanBook `json:"book,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+type CommentList struct {
+	Start    int       `json:"start"`
+	Count    int       `json:"count"`
+	Total    int       `json:"total"`
+	Comments []Comment `json:"comments"`
+}
+
+type Comment struct {
+	ID       string `json:"id"`
+	Author   string `json:"author"`
+	AuthorID string `json:"author_id"`
+	Rating   int    `json:"rating"`
+	Date     string `json:"date"`
+	Votes    int    `json:"votes"`
+	Title    string `json:"title,omitempty"`
+	Content  string `json:"content"`
+	URL      string `json:"url,omitempty"`
+}
+
+type AuthorRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Author struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Photo       string      `json:"photo"`
+	Gender      string      `json:"gender"`
+	Birthdate   string      `json:"birthdate"`
+	Deathdate   string      `json:"deathdate"`
+	Birthplace  string      `json:"birthplace"`
+	Nationality string      `json:"nationality"`
+	Intro       string      `json:"intro"`
+	Works       AuthorWorks `json:"works"`
+}
+
+type AuthorWorks struct {
+	Start int        `json:"start"`
+	Count int        `json:"count"`
+	Total int        `json:"total"`
+	Books []BookItem `json:"books"`
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -14,6 +15,13 @@ type Config struct {
 	Debug     bool
 	BasicUser string
 	BasicPass string
+	DataDir   string
+	RateLimit float64
+	TMDB      bool
+	Plex      bool
+
+	SnapshotInterval time.Duration
+	SnapshotLists    string
 }
 
 func Load() Config {
@@ -26,6 +34,39 @@ func Load() Config {
 
 	defaultCookie := os.Getenv("DOUBAN_COOKIE")
 
+	defaultRateLimit := 0.0
+	if v := os.Getenv("DOUBAN_RATE_LIMIT"); v != "" {
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			defaultRateLimit = n
+		}
+	}
+
+	defaultTMDB := false
+	if v := os.Getenv("DOUBAN_TMDB"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			defaultTMDB = b
+		}
+	}
+
+	var defaultSnapshotInterval time.Duration
+	if v := os.Getenv("DOUBAN_SNAPSHOT_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			defaultSnapshotInterval = d
+		}
+	}
+
+	defaultPlex := false
+	if v := os.Getenv("DOUBAN_PLEX"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			defaultPlex = b
+		}
+	}
+
+	defaultDataDir := "data"
+	if v := os.Getenv("DOUBAN_DATA_DIR"); v != "" {
+		defaultDataDir = v
+	}
+
 	cfg := Config{}
 	flag.StringVar(&cfg.Host, "host", "0.0.0.0", "Listen host")
 	flag.IntVar(&cfg.Port, "port", 8080, "Listen port")
@@ -34,6 +75,12 @@ func Load() Config {
 	flag.BoolVar(&cfg.Debug, "debug", false, "Enable debug mode")
 	flag.StringVar(&cfg.BasicUser, "basic-user", "", "Basic auth username (enable when both basic-user and basic-pass are set)")
 	flag.StringVar(&cfg.BasicPass, "basic-pass", "", "Basic auth password (enable when both basic-user and basic-pass are set)")
+	flag.Float64Var(&cfg.RateLimit, "rate-limit", defaultRateLimit, "Max upstream requests per second shared by all services (unlimited when <= 0)")
+	flag.BoolVar(&cfg.TMDB, "tmdb", defaultTMDB, "Enable the TMDB v3 compatible routes under /tmdb")
+	flag.BoolVar(&cfg.Plex, "plex", defaultPlex, "Enable the Plex metadata provider routes under /plex")
+	flag.StringVar(&cfg.DataDir, "data-dir", defaultDataDir, "Directory for persistent data such as id mappings")
+	flag.DurationVar(&cfg.SnapshotInterval, "snapshot-interval", defaultSnapshotInterval, "Interval for hot list snapshots, e.g. 6h (disabled when 0)")
+	flag.StringVar(&cfg.SnapshotLists, "snapshot-lists", "hot-tv,hot-movie,latest-movie,high-rating-movie", "Comma separated hot lists to snapshot")
 	flag.Parse()
 
 	if cfg.Limit < 0 {
@@ -0,0 +1,96 @@
+package drama
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type parser struct {
+	reImageSize *regexp.Regexp
+}
+
+func newParser() *parser {
+	return &parser{
+		reImageSize: regexp.MustCompile(`/view/(subject|photo)/[a-z_]+/public/`),
+	}
+}
+
+func (p *parser) parseSearchList(doc *goquery.Document, count int) []DoubanDrama {
+	return subject.ParseSearchList(doc, count, func(r subject.SearchResult) DoubanDrama {
+		return DoubanDrama{
+			ID:        r.ID,
+			Title:     r.Title,
+			Aliases:   []string{},
+			Directors: []string{},
+			Writers:   []string{},
+			Cast:      subject.SplitValues(r.Cast),
+			Genres:    []string{},
+			Languages: []string{},
+			Troupes:   []string{},
+			Rating:    Rating{Average: r.Rating},
+			Images:    p.coverImages(r.Cover),
+			Summary:   r.Summary,
+			Tags:      []Tag{},
+		}
+	})
+}
+
+func (p *parser) parseDramaPage(doc *goquery.Document, id string) DoubanDrama {
+	content := doc.Find("#content")
+	cover, _ := content.Find(".pic img, #mainpic img").First().Attr("src")
+
+	attrs := subject.DefinitionList(content.Find("#info dt, .drama-info dt, dl.thing-attr dt"))
+	rating := Rating{
+		Average: subject.ParseRating(content.Find("strong.rating_num").Text()),
+		Votes:   subject.ParseVotes(content),
+	}
+
+	tags := make([]Tag, 0)
+	content.Find("div.tags-body a, div.tags a").Each(func(_ int, t *goquery.Selection) {
+		tags = append(tags, Tag{Name: strings.TrimSpace(t.Text())})
+	})
+
+	summary := strings.TrimSpace(content.Find("#link-report .all").Text())
+	if summary == "" {
+		summary = strings.TrimSpace(content.Find("#link-report span, #link-report p").First().Text())
+	}
+
+	title := strings.TrimSpace(content.Find(`h1 span[property="v:itemreviewed"]`).Text())
+	if title == "" {
+		title = strings.TrimSpace(content.Find("h1").First().Text())
+	}
+
+	return DoubanDrama{
+		ID:        id,
+		Title:     title,
+		Aliases:   subject.SplitValues(attrs["又名"]),
+		Directors: subject.SplitValues(attrs["导演"]),
+		Writers:   subject.SplitValues(subject.FirstNonEmpty(attrs["编剧"], attrs["原作"])),
+		Cast:      subject.SplitValues(subject.FirstNonEmpty(attrs["演员"], attrs["主演"])),
+		Genres:    subject.SplitValues(attrs["类型"]),
+		Languages: subject.SplitValues(attrs["语言"]),
+		Troupes:   subject.SplitValues(subject.FirstNonEmpty(attrs["演出剧团"], attrs["剧团"])),
+		Premiere:  subject.FirstNonEmpty(attrs["首演时间"], attrs["首演日期"], attrs["首演"]),
+		Venue:     subject.FirstNonEmpty(attrs["首演剧院"], attrs["首演剧场"], attrs["剧院"]),
+		Rating:    rating,
+		Images:    p.coverImages(cover),
+		Summary:   summary,
+		Tags:      tags,
+	}
+}
+
+func (p *parser) coverImages(cover string) Image {
+	cover = strings.TrimSpace(cover)
+	if !p.reImageSize.MatchString(cover) {
+		return Image{Small: cover, Medium: cover, Large: cover}
+	}
+	return Image{
+		Small:  p.reImageSize.ReplaceAllString(cover, "/view/$1/s/public/"),
+		Medium: p.reImageSize.ReplaceAllString(cover, "/view/$1/m/public/"),
+		Large:  p.reImageSize.ReplaceAllString(cover, "/view/$1/l/public/"),
+	}
+}
@@ -0,0 +1,97 @@
+package drama
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func loadDocument(t *testing.T, name string) *goquery.Document {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestParseSearchList(t *testing.T) {
+	p := newParser()
+	got := p.parseSearchList(loadDocument(t, "search.html"), 0)
+	want := []DoubanDrama{
+		{
+			ID:        "26322390",
+			Title:     "茶馆",
+			Aliases:   []string{},
+			Directors: []string{},
+			Writers:   []string{},
+			Cast:      []string{"于是之", "郑榕", "蓝天野"},
+			Genres:    []string{},
+			Languages: []string{},
+			Troupes:   []string{},
+			Rating:    Rating{Average: 9.5},
+			Images: Image{
+				Small:  "https://img3.doubanio.com/view/photo/s/public/p2205498583.jpg",
+				Medium: "https://img3.doubanio.com/view/photo/m/public/p2205498583.jpg",
+				Large:  "https://img3.doubanio.com/view/photo/l/public/p2205498583.jpg",
+			},
+			Summary: "北京人艺经典话剧。",
+			Tags:    []Tag{},
+		},
+		{
+			ID:        "30150771",
+			Title:     "茶馆（2018）",
+			Aliases:   []string{},
+			Directors: []string{},
+			Writers:   []string{},
+			Cast:      []string{},
+			Genres:    []string{},
+			Languages: []string{},
+			Troupes:   []string{},
+			Tags:      []Tag{},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSearchList =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := p.parseSearchList(loadDocument(t, "search.html"), 1); len(got) != 1 {
+		t.Errorf("parseSearchList(count=1) returned %d items", len(got))
+	}
+}
+
+func TestParseDramaPage(t *testing.T) {
+	got := newParser().parseDramaPage(loadDocument(t, "subject.html"), "26322390")
+	want := DoubanDrama{
+		ID:        "26322390",
+		Title:     "茶馆",
+		Aliases:   []string{"Teahouse"},
+		Directors: []string{"焦菊隐", "夏淳"},
+		Writers:   []string{"老舍"},
+		Cast:      []string{"于是之", "郑榕", "蓝天野"},
+		Genres:    []string{"话剧"},
+		Languages: []string{"汉语普通话"},
+		Troupes:   []string{"北京人民艺术剧院"},
+		Premiere:  "1958-03-29",
+		Venue:     "首都剧场",
+		Rating:    Rating{Average: 9.5, Votes: 4120},
+		Images: Image{
+			Small:  "https://img3.doubanio.com/view/photo/s/public/p2205498583.jpg",
+			Medium: "https://img3.doubanio.com/view/photo/m/public/p2205498583.jpg",
+			Large:  "https://img3.doubanio.com/view/photo/l/public/p2205498583.jpg",
+		},
+		Summary: "三幕话剧，描写茶馆五十年间的变迁。",
+		Tags:    []Tag{{Name: "话剧"}, {Name: "老舍"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseDramaPage =\n%+v\nwant\n%+v", got, want)
+	}
+}
@@ -0,0 +1,39 @@
+package drama
+
+import (
+	"context"
+
+	"github.com/haigeek/douban-api-go/internal/httpclient"
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type Service struct {
+	subjects *subject.Service[DoubanDrama]
+	parser   *parser
+}
+
+func NewService(client *httpclient.Client) *Service {
+	return &Service{
+		subjects: subject.NewService[DoubanDrama](client, "3069", "https://www.douban.com/location/drama/%s/"),
+		parser:   newParser(),
+	}
+}
+
+func (s *Service) Search(ctx context.Context, q string, count int) (DoubanDramaResult, error) {
+	if q == "" {
+		return DoubanDramaResult{Dramas: []DoubanDrama{}}, nil
+	}
+	doc, err := s.subjects.Search(ctx, q)
+	if err != nil {
+		return DoubanDramaResult{}, err
+	}
+	return DoubanDramaResult{
+		Code:   0,
+		Msg:    "",
+		Dramas: s.parser.parseSearchList(doc, count),
+	}, nil
+}
+
+func (s *Service) GetDramaInfo(ctx context.Context, id string) (DoubanDrama, error) {
+	return s.subjects.Get(ctx, id, s.parser.parseDramaPage)
+}
@@ -0,0 +1,40 @@
+package drama
+
+type DoubanDramaResult struct {
+	Code   uint32        `json:"code"`
+	Msg    string        `json:"msg"`
+	Dramas []DoubanDrama `json:"dramas"`
+}
+
+type DoubanDrama struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Aliases   []string `json:"aliases"`
+	Directors []string `json:"directors"`
+	Writers   []string `json:"writers"`
+	Cast      []string `json:"cast"`
+	Genres    []string `json:"genres"`
+	Languages []string `json:"languages"`
+	Troupes   []string `json:"troupes"`
+	Premiere  string   `json:"premiere"`
+	Venue     string   `json:"venue"`
+	Rating    Rating   `json:"rating"`
+	Images    Image    `json:"images"`
+	Summary   string   `json:"summary"`
+	Tags      []Tag    `json:"tags"`
+}
+
+type Image struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type Rating struct {
+	Average float32 `json:"average"`
+	Votes   int     `json:"votes"`
+}
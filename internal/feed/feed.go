@@ -0,0 +1,280 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+	FormatJSON = "json"
+
+	ContentTypeRSS  = "application/rss+xml; charset=utf-8"
+	ContentTypeAtom = "application/atom+xml; charset=utf-8"
+	ContentTypeJSON = "application/feed+json; charset=utf-8"
+)
+
+var fallbackUpdated = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type Feed struct {
+	ID          string
+	Title       string
+	Link        string
+	FeedURL     string
+	Description string
+	Author      string
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	ID          string
+	Title       string
+	Link        string
+	Description string
+	Image       string
+	Updated     time.Time
+}
+
+func Negotiate(format, accept string) string {
+	switch strings.ToLower(format) {
+	case FormatRSS, FormatAtom, FormatJSON:
+		return strings.ToLower(format)
+	}
+	accept = strings.ToLower(accept)
+	switch {
+	case strings.Contains(accept, "application/rss+xml"):
+		return FormatRSS
+	case strings.Contains(accept, "application/atom+xml"):
+		return FormatAtom
+	case strings.Contains(accept, "application/feed+json"):
+		return FormatJSON
+	}
+	return ""
+}
+
+func Render(f Feed, format string) (string, []byte, error) {
+	switch format {
+	case FormatRSS:
+		body, err := RenderRSS(f)
+		return ContentTypeRSS, body, err
+	case FormatAtom:
+		body, err := RenderAtom(f)
+		return ContentTypeAtom, body, err
+	default:
+		body, err := RenderJSON(f)
+		return ContentTypeJSON, body, err
+	}
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	SelfLink      *atomLink `xml:"atom:link,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link,omitempty"`
+	Description string        `xml:"description,omitempty"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate,omitempty"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+func RenderRSS(f Feed) ([]byte, error) {
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Description,
+		LastBuildDate: f.Updated.Format(time.RFC1123Z),
+		Items:         make([]rssItem, 0, len(f.Items)),
+	}
+	if f.FeedURL != "" {
+		channel.SelfLink = &atomLink{Href: f.FeedURL, Rel: "self", Type: "application/rss+xml"}
+	}
+	for _, it := range f.Items {
+		item := rssItem{
+			Title:       it.Title,
+			Link:        it.Link,
+			Description: it.Description,
+			GUID:        rssGUID{IsPermaLink: false, Value: it.ID},
+		}
+		if !it.Updated.IsZero() {
+			item.PubDate = it.Updated.Format(time.RFC1123Z)
+		}
+		if it.Image != "" {
+			item.Enclosure = &rssEnclosure{URL: it.Image, Type: imageType(it.Image)}
+		}
+		channel.Items = append(channel.Items, item)
+	}
+	return marshalXML(rssDocument{Version: "2.0", Atom: "http://www.w3.org/2005/Atom", Channel: channel})
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Links   []atomLink   `xml:"link"`
+	Summary *atomSummary `xml:"summary,omitempty"`
+}
+
+type atomSummary struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func RenderAtom(f Feed) ([]byte, error) {
+	doc := atomFeed{
+		ID:      f.ID,
+		Title:   f.Title,
+		Updated: f.Updated.Format(time.RFC3339),
+		Author:  atomAuthor{Name: f.Author},
+		Links:   []atomLink{{Href: f.Link, Rel: "alternate"}},
+		Entries: make([]atomEntry, 0, len(f.Items)),
+	}
+	if doc.Author.Name == "" {
+		doc.Author.Name = f.Title
+	}
+	if f.FeedURL != "" {
+		doc.Links = append(doc.Links, atomLink{Href: f.FeedURL, Rel: "self", Type: "application/atom+xml"})
+	}
+	for _, it := range f.Items {
+		updated := it.Updated
+		if updated.IsZero() {
+			updated = fallbackUpdated
+		}
+		entry := atomEntry{
+			ID:      it.ID,
+			Title:   it.Title,
+			Updated: updated.Format(time.RFC3339),
+			Links:   make([]atomLink, 0, 2),
+		}
+		if it.Link != "" {
+			entry.Links = append(entry.Links, atomLink{Href: it.Link, Rel: "alternate"})
+		}
+		if it.Image != "" {
+			entry.Links = append(entry.Links, atomLink{Href: it.Image, Rel: "enclosure", Type: imageType(it.Image)})
+		}
+		if it.Description != "" {
+			entry.Summary = &atomSummary{Type: "text", Value: it.Description}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	return marshalXML(doc)
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Authors     []jsonAuthor   `json:"authors,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID           string `json:"id"`
+	URL          string `json:"url,omitempty"`
+	Title        string `json:"title"`
+	ContentText  string `json:"content_text"`
+	Image        string `json:"image,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+func RenderJSON(f Feed) ([]byte, error) {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Items:       make([]jsonFeedItem, 0, len(f.Items)),
+	}
+	if f.Author != "" {
+		doc.Authors = []jsonAuthor{{Name: f.Author}}
+	}
+	for _, it := range f.Items {
+		item := jsonFeedItem{
+			ID:          it.ID,
+			URL:         it.Link,
+			Title:       it.Title,
+			ContentText: it.Description,
+			Image:       it.Image,
+		}
+		if !it.Updated.IsZero() {
+			item.DateModified = it.Updated.Format(time.RFC3339)
+		}
+		doc.Items = append(doc.Items, item)
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func imageType(url string) string {
+	lower := strings.ToLower(url)
+	switch {
+	case strings.HasSuffix(lower, ".png"):
+		return "image/png"
+	case strings.HasSuffix(lower, ".webp"):
+		return "image/webp"
+	default:
+		return "image/jpeg"
+	}
+}
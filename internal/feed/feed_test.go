@@ -0,0 +1,93 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed() Feed {
+	return Feed{
+		ID:      "http://localhost/v2/media/top250",
+		Title:   "豆瓣电影 Top 250",
+		Link:    "https://movie.douban.com/top250",
+		FeedURL: "http://localhost/v2/media/top250?format=atom&start=25",
+		Author:  "豆瓣",
+		Updated: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+		Items: []Item{{
+			ID:          "tag:douban.com,2005:subject/1292052",
+			Title:       "肖申克的救赎 & more",
+			Link:        "https://movie.douban.com/subject/1292052/",
+			Description: "No.1",
+			Image:       "https://img2.doubanio.com/view/photo/s_ratio_poster/public/p480747492.webp",
+		}},
+	}
+}
+
+func TestRenderAtom(t *testing.T) {
+	body, err := RenderAtom(testFeed())
+	if err != nil {
+		t.Fatalf("RenderAtom: %v", err)
+	}
+	var doc struct {
+		ID      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Author  struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Links []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Entries []struct {
+			Title   string `xml:"title"`
+			Updated string `xml:"updated"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("unmarshal: %v\n%s", err, body)
+	}
+	if doc.ID != "http://localhost/v2/media/top250" || doc.Author.Name != "豆瓣" || doc.Updated != "2024-05-06T07:08:09Z" {
+		t.Errorf("feed = %+v", doc)
+	}
+	if len(doc.Links) != 2 || doc.Links[1].Rel != "self" || doc.Links[1].Href != testFeed().FeedURL {
+		t.Errorf("links = %+v", doc.Links)
+	}
+	if len(doc.Entries) != 1 || doc.Entries[0].Title != "肖申克的救赎 & more" || doc.Entries[0].Updated != "2000-01-01T00:00:00Z" {
+		t.Errorf("entries = %+v", doc.Entries)
+	}
+
+	f := testFeed()
+	f.Author = ""
+	body, _ = RenderAtom(f)
+	if !strings.Contains(string(body), "<author>\n    <name>豆瓣电影 Top 250</name>") {
+		t.Errorf("atom without author falls back to the title:\n%s", body)
+	}
+}
+
+func TestRenderJSONAuthors(t *testing.T) {
+	body, err := RenderJSON(testFeed())
+	if err != nil {
+		t.Fatalf("RenderJSON: %v", err)
+	}
+	if !strings.Contains(string(body), `"authors": [`) || !strings.Contains(string(body), `"name": "豆瓣"`) {
+		t.Errorf("json feed missing authors:\n%s", body)
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		format, accept, want string
+	}{
+		{"RSS", "", FormatRSS},
+		{"", "application/atom+xml, */*", FormatAtom},
+		{"json", "application/rss+xml", FormatJSON},
+		{"", "application/json", ""},
+	}
+	for _, tt := range tests {
+		if got := Negotiate(tt.format, tt.accept); got != tt.want {
+			t.Errorf("Negotiate(%q, %q) = %q, want %q", tt.format, tt.accept, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package game
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type parser struct {
+	reImageSize *regexp.Regexp
+	rePhotoSize *regexp.Regexp
+}
+
+func newParser() *parser {
+	return &parser{
+		reImageSize: regexp.MustCompile(`/view/subject/[sml]/public/`),
+		rePhotoSize: regexp.MustCompile(`/view/photo/[a-z_]+/public/`),
+	}
+}
+
+func (p *parser) parseSearchList(doc *goquery.Document, count int) []DoubanGame {
+	return subject.ParseSearchList(doc, count, func(r subject.SearchResult) DoubanGame {
+		platforms, genres, releaseDates := p.parseSubjectCast(r.Cast)
+		return DoubanGame{
+			ID:           r.ID,
+			Title:        r.Title,
+			Aliases:      []string{},
+			Genres:       genres,
+			Platforms:    platforms,
+			Developers:   []string{},
+			Publishers:   []string{},
+			ReleaseDates: releaseDates,
+			Rating:       Rating{Average: r.Rating},
+			Images:       p.coverImages(r.Cover),
+			Screenshots:  []Screenshot{},
+			Summary:      r.Summary,
+			Tags:         []Tag{},
+		}
+	})
+}
+
+func (p *parser) parseGamePage(doc *goquery.Document, id string) DoubanGame {
+	content := doc.Find("#content")
+	cover, _ := content.Find(".item-subject-info .pic img").First().Attr("src")
+
+	attrs := subject.DefinitionList(content.Find("dl.thing-attr dt"))
+	rating := Rating{
+		Average: subject.ParseRating(content.Find("strong.rating_num").Text()),
+		Votes:   subject.ParseVotes(content),
+	}
+
+	screenshots := make([]Screenshot, 0)
+	content.Find("ul.pic-list img").Each(func(_ int, img *goquery.Selection) {
+		thumb := strings.TrimSpace(img.AttrOr("src", ""))
+		if thumb == "" {
+			return
+		}
+		screenshots = append(screenshots, Screenshot{
+			Thumb: thumb,
+			Large: p.rePhotoSize.ReplaceAllString(thumb, "/view/photo/l/public/"),
+		})
+	})
+
+	tags := make([]Tag, 0)
+	content.Find("div.tags-body a, div.tags a").Each(func(_ int, t *goquery.Selection) {
+		tags = append(tags, Tag{Name: strings.TrimSpace(t.Text())})
+	})
+
+	summary := strings.TrimSpace(content.Find("#link-report .all").Text())
+	if summary == "" {
+		summary = strings.TrimSpace(content.Find("div.item-desc p, #link-report p").First().Text())
+	}
+
+	return DoubanGame{
+		ID:           id,
+		Title:        strings.TrimSpace(content.Find("h1").First().Text()),
+		Aliases:      subject.SplitValues(attrs["别名"]),
+		Genres:       subject.SplitValues(attrs["类型"]),
+		Platforms:    subject.SplitValues(attrs["平台"]),
+		Developers:   subject.SplitValues(attrs["开发商"]),
+		Publishers:   subject.SplitValues(attrs["发行商"]),
+		ReleaseDates: subject.SplitValues(attrs["发行日期"]),
+		Rating:       rating,
+		Images:       p.coverImages(cover),
+		Screenshots:  screenshots,
+		Summary:      summary,
+		Tags:         tags,
+	}
+}
+
+func (p *parser) parseSubjectCast(text string) ([]string, []string, []string) {
+	platforms := make([]string, 0)
+	genres := make([]string, 0)
+	releaseDates := make([]string, 0)
+	for _, part := range strings.Split(text, "/") {
+		v := strings.TrimSpace(part)
+		switch {
+		case v == "":
+		case len(v) >= 4 && isDigits(v[:4]):
+			releaseDates = append(releaseDates, v)
+		case len(platforms) == 0:
+			platforms = append(platforms, v)
+		default:
+			genres = append(genres, v)
+		}
+	}
+	return platforms, genres, releaseDates
+}
+
+func (p *parser) coverImages(cover string) Image {
+	cover = strings.TrimSpace(cover)
+	if !p.reImageSize.MatchString(cover) {
+		return Image{Small: cover, Medium: cover, Large: cover}
+	}
+	return Image{
+		Small:  p.reImageSize.ReplaceAllString(cover, "/view/subject/s/public/"),
+		Medium: p.reImageSize.ReplaceAllString(cover, "/view/subject/m/public/"),
+		Large:  p.reImageSize.ReplaceAllString(cover, "/view/subject/l/public/"),
+	}
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
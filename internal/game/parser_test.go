@@ -0,0 +1,98 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func loadDocument(t *testing.T, name string) *goquery.Document {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestParseSearchList(t *testing.T) {
+	p := newParser()
+	got := p.parseSearchList(loadDocument(t, "search.html"), 0)
+	want := []DoubanGame{
+		{
+			ID:           "25931701",
+			Title:        "塞尔达传说：旷野之息",
+			Aliases:      []string{},
+			Genres:       []string{"动作", "冒险"},
+			Platforms:    []string{"Switch"},
+			Developers:   []string{},
+			Publishers:   []string{},
+			ReleaseDates: []string{"2017-03-03"},
+			Rating:       Rating{Average: 9.7},
+			Images: Image{
+				Small:  "https://img9.doubanio.com/view/subject/s/public/s29378440.jpg",
+				Medium: "https://img9.doubanio.com/view/subject/m/public/s29378440.jpg",
+				Large:  "https://img9.doubanio.com/view/subject/l/public/s29378440.jpg",
+			},
+			Screenshots: []Screenshot{},
+			Summary:     "开放世界动作冒险游戏。",
+			Tags:        []Tag{},
+		},
+		{
+			ID:           "35427468",
+			Title:        "塞尔达传说：王国之泪",
+			Aliases:      []string{},
+			Genres:       []string{},
+			Platforms:    []string{"Switch"},
+			Developers:   []string{},
+			Publishers:   []string{},
+			ReleaseDates: []string{"2023"},
+			Screenshots:  []Screenshot{},
+			Tags:         []Tag{},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSearchList =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := p.parseSearchList(loadDocument(t, "search.html"), 1); len(got) != 1 {
+		t.Errorf("parseSearchList(count=1) returned %d items", len(got))
+	}
+}
+
+func TestParseGamePage(t *testing.T) {
+	got := newParser().parseGamePage(loadDocument(t, "subject.html"), "25931701")
+	want := DoubanGame{
+		ID:           "25931701",
+		Title:        "塞尔达传说：旷野之息",
+		Aliases:      []string{"薩爾達傳說 曠野之息", "The Legend of Zelda: Breath of the Wild"},
+		Genres:       []string{"动作", "冒险"},
+		Platforms:    []string{"Nintendo Switch", "Wii U"},
+		Developers:   []string{"Nintendo EPD"},
+		Publishers:   []string{"Nintendo"},
+		ReleaseDates: []string{"2017-03-03", "2018-03-01"},
+		Rating:       Rating{Average: 9.7, Votes: 38261},
+		Images: Image{
+			Small:  "https://img9.doubanio.com/view/subject/s/public/s29378440.jpg",
+			Medium: "https://img9.doubanio.com/view/subject/m/public/s29378440.jpg",
+			Large:  "https://img9.doubanio.com/view/subject/l/public/s29378440.jpg",
+		},
+		Screenshots: []Screenshot{
+			{Thumb: "https://img1.doubanio.com/view/photo/thumb/public/p2390000001.jpg", Large: "https://img1.doubanio.com/view/photo/l/public/p2390000001.jpg"},
+			{Thumb: "https://img1.doubanio.com/view/photo/photo/public/p2390000002.jpg", Large: "https://img1.doubanio.com/view/photo/l/public/p2390000002.jpg"},
+		},
+		Summary: "在广阔的海拉鲁大地上自由冒险。",
+		Tags:    []Tag{{Name: "任天堂"}, {Name: "开放世界"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseGamePage =\n%+v\nwant\n%+v", got, want)
+	}
+}
@@ -0,0 +1,39 @@
+package game
+
+import (
+	"context"
+
+	"github.com/haigeek/douban-api-go/internal/httpclient"
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type Service struct {
+	subjects *subject.Service[DoubanGame]
+	parser   *parser
+}
+
+func NewService(client *httpclient.Client) *Service {
+	return &Service{
+		subjects: subject.NewService[DoubanGame](client, "3114", "https://www.douban.com/game/%s/"),
+		parser:   newParser(),
+	}
+}
+
+func (s *Service) Search(ctx context.Context, q string, count int) (DoubanGameResult, error) {
+	if q == "" {
+		return DoubanGameResult{Games: []DoubanGame{}}, nil
+	}
+	doc, err := s.subjects.Search(ctx, q)
+	if err != nil {
+		return DoubanGameResult{}, err
+	}
+	return DoubanGameResult{
+		Code:  0,
+		Msg:   "",
+		Games: s.parser.parseSearchList(doc, count),
+	}, nil
+}
+
+func (s *Service) GetGameInfo(ctx context.Context, id string) (DoubanGame, error) {
+	return s.subjects.Get(ctx, id, s.parser.parseGamePage)
+}
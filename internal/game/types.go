@@ -0,0 +1,43 @@
+package game
+
+type DoubanGameResult struct {
+	Code  uint32       `json:"code"`
+	Msg   string       `json:"msg"`
+	Games []DoubanGame `json:"games"`
+}
+
+type DoubanGame struct {
+	ID           string       `json:"id"`
+	Title        string       `json:"title"`
+	Aliases      []string     `json:"aliases"`
+	Genres       []string     `json:"genres"`
+	Platforms    []string     `json:"platforms"`
+	Developers   []string     `json:"developers"`
+	Publishers   []string     `json:"publishers"`
+	ReleaseDates []string     `json:"release_dates"`
+	Rating       Rating       `json:"rating"`
+	Images       Image        `json:"images"`
+	Screenshots  []Screenshot `json:"screenshots"`
+	Summary      string       `json:"summary"`
+	Tags         []Tag        `json:"tags"`
+}
+
+type Screenshot struct {
+	Thumb string `json:"thumb"`
+	Large string `json:"large"`
+}
+
+type Image struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type Rating struct {
+	Average float32 `json:"average"`
+	Votes   int     `json:"votes"`
+}
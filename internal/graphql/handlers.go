@@ -0,0 +1,73 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+	"github.com/haigeek/douban-api-go/internal/book"
+	"github.com/haigeek/douban-api-go/internal/media"
+)
+
+type Handlers struct {
+	movie  *movie.Service
+	book   *book.Service
+	schema *graphql.Schema
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func errorResponse(message string) gin.H {
+	return gin.H{"errors": []gin.H{{"message": message}}}
+}
+
+func NewHandlers(movieService *movie.Service, bookService *book.Service, mediaService *media.Service) (*Handlers, error) {
+	schema, err := newSchema(movieService, bookService, mediaService)
+	if err != nil {
+		return nil, err
+	}
+	return &Handlers{
+		movie:  movieService,
+		book:   bookService,
+		schema: schema,
+	}, nil
+}
+
+func (h *Handlers) Query(c *gin.Context) {
+	var req request
+	if c.Request.Method == http.MethodPost {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, errorResponse("invalid request body"))
+			return
+		}
+	} else {
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if raw := c.Query("variables"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &req.Variables); err != nil {
+				c.JSON(http.StatusBadRequest, errorResponse("invalid variables"))
+				return
+			}
+		}
+	}
+	if req.Query == "" {
+		c.JSON(http.StatusBadRequest, errorResponse("query is required"))
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), loadersKey{}, newLoaders(h.movie, h.book))
+	resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	if resp.Data == nil {
+		c.JSON(http.StatusBadRequest, resp)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type Loader[K comparable, V any] struct {
+	fetch   func(ctx context.Context, key K) (V, error)
+	mu      sync.Mutex
+	entries map[K]*loaderEntry[V]
+}
+
+type loaderEntry[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+func NewLoader[K comparable, V any](fetch func(ctx context.Context, key K) (V, error)) *Loader[K, V] {
+	return &Loader[K, V]{fetch: fetch, entries: make(map[K]*loaderEntry[V])}
+}
+
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	entry, ok := l.entries[key]
+	if !ok {
+		entry = &loaderEntry[V]{done: make(chan struct{})}
+		l.entries[key] = entry
+		l.mu.Unlock()
+
+		l.run(ctx, key, entry)
+		return entry.value, entry.err
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-entry.done:
+		return entry.value, entry.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (l *Loader[K, V]) run(ctx context.Context, key K, entry *loaderEntry[V]) {
+	defer close(entry.done)
+	defer func() {
+		if r := recover(); r != nil {
+			entry.err = fmt.Errorf("load %v: %v", key, r)
+		}
+	}()
+	entry.value, entry.err = l.fetch(ctx, key)
+}
@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoaderDedupes(t *testing.T) {
+	var calls atomic.Int64
+	release := make(chan struct{})
+	l := NewLoader(func(_ context.Context, key string) (string, error) {
+		calls.Add(1)
+		<-release
+		return "v" + key, nil
+	})
+
+	var wg sync.WaitGroup
+	results := make([]string, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = l.Load(context.Background(), "1")
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, r := range results {
+		if r != "v1" {
+			t.Fatalf("results = %q", results)
+		}
+	}
+	if v, _ := l.Load(context.Background(), "1"); v != "v1" || calls.Load() != 1 {
+		t.Fatalf("cached load = %q after %d fetches", v, calls.Load())
+	}
+	if v, _ := l.Load(context.Background(), "2"); v != "v2" || calls.Load() != 2 {
+		t.Fatalf("second key = %q after %d fetches", v, calls.Load())
+	}
+}
+
+func TestLoaderCachesErrors(t *testing.T) {
+	var calls atomic.Int64
+	boom := errors.New("boom")
+	l := NewLoader(func(context.Context, string) (int, error) {
+		calls.Add(1)
+		return 0, boom
+	})
+	for i := 0; i < 3; i++ {
+		if _, err := l.Load(context.Background(), "x"); !errors.Is(err, boom) {
+			t.Fatalf("Load = %v, want boom", err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("fetches = %d, want 1", calls.Load())
+	}
+}
+
+func TestLoaderPanicReleasesWaiters(t *testing.T) {
+	started := make(chan struct{})
+	l := NewLoader(func(context.Context, string) (int, error) {
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		panic("fetch failed")
+	})
+
+	waiter := make(chan error, 1)
+	go func() {
+		<-started
+		_, err := l.Load(context.Background(), "x")
+		waiter <- err
+	}()
+
+	if _, err := l.Load(context.Background(), "x"); err == nil {
+		t.Fatal("Load after panic returned nil error")
+	}
+	select {
+	case err := <-waiter:
+		if err == nil {
+			t.Fatal("waiter got nil error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter hung after the fetch panicked")
+	}
+}
+
+func TestLoaderWaiterHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	l := NewLoader(func(context.Context, string) (int, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	go l.Load(context.Background(), "x")
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Load(ctx, "x"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Load = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestLoadersBudget(t *testing.T) {
+	l := newLoaders(nil, nil)
+	if err := l.spend(maxUpstreamRequests); err != nil {
+		t.Fatalf("spend within budget: %v", err)
+	}
+	if err := l.spend(1); !errors.Is(err, errBudgetExceeded) {
+		t.Fatalf("spend over budget = %v, want errBudgetExceeded", err)
+	}
+	if _, err := l.book.Load(context.Background(), "1"); !errors.Is(err, errBudgetExceeded) {
+		t.Fatalf("load over budget = %v, want errBudgetExceeded", err)
+	}
+}
@@ -0,0 +1,562 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+	"github.com/haigeek/douban-api-go/internal/book"
+	"github.com/haigeek/douban-api-go/internal/media"
+)
+
+const (
+	maxSearchCount      = 20
+	maxListLimit        = 50
+	maxCredits          = 20
+	maxPhotos           = 20
+	maxDepth            = 15
+	maxQueryLength      = 8192
+	maxUpstreamRequests = 200
+)
+
+var errBudgetExceeded = errors.New("query exceeds the upstream request budget")
+
+const schemaSDL = `
+schema {
+	query: Query
+}
+
+type Query {
+	movie(id: ID!): Movie
+	movieByImdb(id: ID!): Movie
+	searchMovies(q: String!, count: Int = 0): [MovieSummary!]!
+	celebrity(id: ID!): CelebrityProfile
+	book(id: ID!): Book
+	bookByIsbn(isbn: String!): Book
+	searchBooks(q: String!, start: Int = 0, count: Int = 2): [Book!]!
+	hotMovies(start: Int = 0, limit: Int = 20): [MediaItem!]!
+	hotTv(start: Int = 0, limit: Int = 20): [MediaItem!]!
+	latestMovies(start: Int = 0, limit: Int = 20): [MediaItem!]!
+	highRatingMovies(start: Int = 0, limit: Int = 20): [MediaItem!]!
+	top250(start: Int = 0, limit: Int = 20): [Top250Item!]!
+}
+
+type Movie {
+	id: ID!
+	name: String!
+	originalName: String!
+	rating: String!
+	votes: String!
+	img: String!
+	year: String!
+	intro: String!
+	director: String!
+	writer: String!
+	actor: String!
+	genre: String!
+	site: String!
+	country: String!
+	language: String!
+	screen: String!
+	duration: String!
+	episodes: String!
+	subname: String!
+	imdb: String!
+	credits: [Credit!]!
+	photos: [Photo!]!
+}
+
+type Credit {
+	id: ID!
+	img: String!
+	name: String!
+	role: String!
+	roleType: String!
+	celebrity: CelebrityProfile
+}
+
+type CelebrityProfile {
+	id: ID!
+	img: String!
+	name: String!
+	role: String!
+	intro: String!
+	gender: String!
+	constellation: String!
+	birthdate: String!
+	birthplace: String!
+	nickname: String!
+	imdb: String!
+	family: String!
+}
+
+type Photo {
+	id: ID!
+	small: String!
+	medium: String!
+	large: String!
+	size: String!
+	width: String!
+	height: String!
+}
+
+type MovieSummary {
+	id: ID!
+	cat: String!
+	name: String!
+	rating: String!
+	img: String!
+	year: String!
+	movie: Movie
+}
+
+type MediaItem {
+	id: ID!
+	type: String!
+	title: String!
+	year: String!
+	url: String!
+	cardSubtitle: String!
+	episodesInfo: String!
+	rating: Float
+	cover: String
+	movie: Movie
+}
+
+type Top250Item {
+	rank: Int!
+	id: ID!
+	title: String!
+	originalTitle: String!
+	otherTitles: [String!]!
+	year: String!
+	rating: Float!
+	votes: Int!
+	quote: String!
+	pic: String!
+	url: String!
+	movie: Movie
+}
+
+type Book {
+	id: ID!
+	title: String!
+	subtitle: String!
+	origin: String!
+	author: [String!]!
+	authorIntro: String!
+	translators: [String!]!
+	publisher: String!
+	producer: String!
+	pubdate: String!
+	pages: String!
+	pageCount: Int!
+	price: String!
+	isbn13: String!
+	binding: String!
+	bindingType: String!
+	serials: String!
+	seriesId: String!
+	worksId: String!
+	summary: String!
+	catalog: String!
+	rating: Float!
+	image: String!
+	tags: [String!]!
+}
+`
+
+type loaders struct {
+	movie       *Loader[string, movie.MovieInfo]
+	celebrities *Loader[string, []movie.Celebrity]
+	photos      *Loader[string, []movie.Photo]
+	celebrity   *Loader[string, movie.CelebrityInfo]
+	book        *Loader[string, book.DoubanBook]
+
+	remaining atomic.Int64
+}
+
+type loadersKey struct{}
+
+func newLoaders(movieService *movie.Service, bookService *book.Service) *loaders {
+	l := &loaders{}
+	l.remaining.Store(maxUpstreamRequests)
+	l.movie = NewLoader(budgeted(l, 1, func(ctx context.Context, sid string) (movie.MovieInfo, error) {
+		return movieService.GetMovieInfo(ctx, sid, "")
+	}))
+	l.celebrities = NewLoader(budgeted(l, 1, movieService.GetCelebrities))
+	l.photos = NewLoader(budgeted(l, 1, movieService.GetWallpaper))
+	l.celebrity = NewLoader(budgeted(l, 1, movieService.GetCelebrity))
+	l.book = NewLoader(budgeted(l, 1, bookService.GetBookInfo))
+	return l
+}
+
+func (l *loaders) spend(n int64) error {
+	if l.remaining.Add(-n) < 0 {
+		return errBudgetExceeded
+	}
+	return nil
+}
+
+func budgeted[K comparable, V any](l *loaders, n int64, fetch func(ctx context.Context, key K) (V, error)) func(ctx context.Context, key K) (V, error) {
+	return func(ctx context.Context, key K) (V, error) {
+		if err := l.spend(n); err != nil {
+			var zero V
+			return zero, err
+		}
+		return fetch(ctx, key)
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func newSchema(movieService *movie.Service, bookService *book.Service, mediaService *media.Service) (*graphql.Schema, error) {
+	root := &queryResolver{movie: movieService, book: bookService, media: mediaService}
+	return graphql.ParseSchema(schemaSDL, root,
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxQueryLength(maxQueryLength),
+	)
+}
+
+type queryResolver struct {
+	movie *movie.Service
+	book  *book.Service
+	media *media.Service
+}
+
+type idArgs struct {
+	ID graphql.ID
+}
+
+type pageArgs struct {
+	Start int32
+	Limit int32
+}
+
+func (r *queryResolver) Movie(ctx context.Context, args idArgs) (*movieResolver, error) {
+	return loadMovie(ctx, string(args.ID))
+}
+
+func (r *queryResolver) MovieByImdb(ctx context.Context, args idArgs) (*movieResolver, error) {
+	if err := loadersFrom(ctx).spend(4); err != nil {
+		return nil, err
+	}
+	info, err := r.movie.GetMovieInfoByIMDB(ctx, string(args.ID), "")
+	if errors.Is(err, movie.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &movieResolver{info}, nil
+}
+
+func (r *queryResolver) SearchMovies(ctx context.Context, args struct {
+	Q     string
+	Count int32
+}) ([]*movieSummaryResolver, error) {
+	if args.Count < 0 || args.Count > maxSearchCount {
+		return nil, errors.New("count must be between 0 and 20")
+	}
+	if err := loadersFrom(ctx).spend(1); err != nil {
+		return nil, err
+	}
+	movies, err := r.movie.Search(ctx, args.Q, int(args.Count), "")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*movieSummaryResolver, len(movies))
+	for i, m := range movies {
+		result[i] = &movieSummaryResolver{m}
+	}
+	return result, nil
+}
+
+func (r *queryResolver) Celebrity(ctx context.Context, args idArgs) (*celebrityResolver, error) {
+	info, err := loadersFrom(ctx).celebrity.Load(ctx, string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	return &celebrityResolver{info}, nil
+}
+
+func (r *queryResolver) Book(ctx context.Context, args idArgs) (*bookResolver, error) {
+	b, err := loadersFrom(ctx).book.Load(ctx, string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	return &bookResolver{b}, nil
+}
+
+func (r *queryResolver) BookByIsbn(ctx context.Context, args struct{ Isbn string }) (*bookResolver, error) {
+	if err := loadersFrom(ctx).spend(2); err != nil {
+		return nil, err
+	}
+	b, err := r.book.GetBookInfoByISBN(ctx, args.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	return &bookResolver{b}, nil
+}
+
+func (r *queryResolver) SearchBooks(ctx context.Context, args struct {
+	Q     string
+	Start int32
+	Count int32
+}) ([]*bookResolver, error) {
+	if args.Start < 0 || args.Count < 0 || args.Count > maxSearchCount {
+		return nil, errors.New("invalid start or count")
+	}
+	if err := loadersFrom(ctx).spend(int64(args.Count) + 1); err != nil {
+		return nil, err
+	}
+	result, err := r.book.Search(ctx, args.Q, int(args.Start), int(args.Count))
+	if err != nil {
+		return nil, err
+	}
+	books := make([]*bookResolver, len(result.Books))
+	for i, b := range result.Books {
+		books[i] = &bookResolver{b}
+	}
+	return books, nil
+}
+
+func (r *queryResolver) HotMovies(ctx context.Context, args pageArgs) ([]*mediaItemResolver, error) {
+	return hotList(ctx, args, r.media.HotMovie)
+}
+
+func (r *queryResolver) HotTv(ctx context.Context, args pageArgs) ([]*mediaItemResolver, error) {
+	return hotList(ctx, args, r.media.HotTV)
+}
+
+func (r *queryResolver) LatestMovies(ctx context.Context, args pageArgs) ([]*mediaItemResolver, error) {
+	return hotList(ctx, args, r.media.LatestMovie)
+}
+
+func (r *queryResolver) HighRatingMovies(ctx context.Context, args pageArgs) ([]*mediaItemResolver, error) {
+	return hotList(ctx, args, r.media.HighRatingMovie)
+}
+
+func (r *queryResolver) Top250(ctx context.Context, args pageArgs) ([]*top250ItemResolver, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	if err := loadersFrom(ctx).spend(10); err != nil {
+		return nil, err
+	}
+	result, err := r.media.Top250(ctx, int(args.Start), int(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*top250ItemResolver, len(result.Items))
+	for i, it := range result.Items {
+		items[i] = &top250ItemResolver{it}
+	}
+	return items, nil
+}
+
+func hotList(ctx context.Context, args pageArgs, fetch func(ctx context.Context, start, limit int) (media.HotMediaResponse, error)) ([]*mediaItemResolver, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	if err := loadersFrom(ctx).spend(1); err != nil {
+		return nil, err
+	}
+	result, err := fetch(ctx, int(args.Start), int(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*mediaItemResolver, len(result.Items))
+	for i, it := range result.Items {
+		items[i] = &mediaItemResolver{it}
+	}
+	return items, nil
+}
+
+func (a pageArgs) validate() error {
+	if a.Start < 0 || a.Limit <= 0 || a.Limit > maxListLimit {
+		return errors.New("start must be >= 0 and limit between 1 and 50")
+	}
+	return nil
+}
+
+func loadMovie(ctx context.Context, sid string) (*movieResolver, error) {
+	if sid == "" {
+		return nil, nil
+	}
+	info, err := loadersFrom(ctx).movie.Load(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	return &movieResolver{info}, nil
+}
+
+type movieResolver struct {
+	movie.MovieInfo
+}
+
+func (r *movieResolver) ID() graphql.ID {
+	return graphql.ID(r.SID)
+}
+
+func (r *movieResolver) Credits(ctx context.Context) ([]*creditResolver, error) {
+	credits, err := loadersFrom(ctx).celebrities.Load(ctx, r.SID)
+	if err != nil {
+		return nil, err
+	}
+	if len(credits) > maxCredits {
+		credits = credits[:maxCredits]
+	}
+	result := make([]*creditResolver, len(credits))
+	for i, c := range credits {
+		result[i] = &creditResolver{c}
+	}
+	return result, nil
+}
+
+func (r *movieResolver) Photos(ctx context.Context) ([]*photoResolver, error) {
+	photos, err := loadersFrom(ctx).photos.Load(ctx, r.SID)
+	if err != nil {
+		return nil, err
+	}
+	if len(photos) > maxPhotos {
+		photos = photos[:maxPhotos]
+	}
+	result := make([]*photoResolver, len(photos))
+	for i, p := range photos {
+		result[i] = &photoResolver{p}
+	}
+	return result, nil
+}
+
+type creditResolver struct {
+	c movie.Celebrity
+}
+
+func (r *creditResolver) ID() graphql.ID   { return graphql.ID(r.c.ID) }
+func (r *creditResolver) Img() string      { return r.c.Img }
+func (r *creditResolver) Name() string     { return r.c.Name }
+func (r *creditResolver) Role() string     { return r.c.Role }
+func (r *creditResolver) RoleType() string { return r.c.RoleType }
+
+func (r *creditResolver) Celebrity(ctx context.Context) (*celebrityResolver, error) {
+	if r.c.ID == "" {
+		return nil, nil
+	}
+	info, err := loadersFrom(ctx).celebrity.Load(ctx, r.c.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &celebrityResolver{info}, nil
+}
+
+type celebrityResolver struct {
+	movie.CelebrityInfo
+}
+
+func (r *celebrityResolver) ID() graphql.ID {
+	return graphql.ID(r.CelebrityInfo.ID)
+}
+
+type photoResolver struct {
+	movie.Photo
+}
+
+func (r *photoResolver) ID() graphql.ID {
+	return graphql.ID(r.Photo.ID)
+}
+
+type movieSummaryResolver struct {
+	m movie.Movie
+}
+
+func (r *movieSummaryResolver) ID() graphql.ID { return graphql.ID(r.m.SID) }
+func (r *movieSummaryResolver) Cat() string    { return r.m.Cat }
+func (r *movieSummaryResolver) Name() string   { return r.m.Name }
+func (r *movieSummaryResolver) Rating() string { return r.m.Rating }
+func (r *movieSummaryResolver) Img() string    { return r.m.Img }
+func (r *movieSummaryResolver) Year() string   { return r.m.Year }
+
+func (r *movieSummaryResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return loadMovie(ctx, r.m.SID)
+}
+
+type mediaItemResolver struct {
+	media.HotMediaItem
+}
+
+func (r *mediaItemResolver) ID() graphql.ID {
+	return graphql.ID(r.HotMediaItem.ID)
+}
+
+func (r *mediaItemResolver) Rating() *float64 {
+	if r.HotMediaItem.Rating == nil {
+		return nil
+	}
+	return &r.HotMediaItem.Rating.Value
+}
+
+func (r *mediaItemResolver) Cover() *string {
+	if r.Pic == nil {
+		return nil
+	}
+	return &r.Pic.Large
+}
+
+func (r *mediaItemResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return loadMovie(ctx, r.HotMediaItem.ID)
+}
+
+type top250ItemResolver struct {
+	media.Top250Item
+}
+
+func (r *top250ItemResolver) ID() graphql.ID {
+	return graphql.ID(r.Top250Item.ID)
+}
+
+func (r *top250ItemResolver) Rank() int32 {
+	return int32(r.Top250Item.Rank)
+}
+
+func (r *top250ItemResolver) Votes() int32 {
+	return int32(r.Top250Item.Votes)
+}
+
+func (r *top250ItemResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return loadMovie(ctx, r.Top250Item.ID)
+}
+
+type bookResolver struct {
+	book.DoubanBook
+}
+
+func (r *bookResolver) ID() graphql.ID {
+	return graphql.ID(r.DoubanBook.ID)
+}
+
+func (r *bookResolver) PageCount() int32 {
+	return int32(r.DoubanBook.PageCount)
+}
+
+func (r *bookResolver) Rating() float64 {
+	return float64(r.DoubanBook.Rating.Average)
+}
+
+func (r *bookResolver) Image() string {
+	return r.Images.Large
+}
+
+func (r *bookResolver) Tags() []string {
+	tags := make([]string, 0, len(r.DoubanBook.Tags))
+	for _, t := range r.DoubanBook.Tags {
+		tags = append(tags, t.Name)
+	}
+	return tags
+}
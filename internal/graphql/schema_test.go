@@ -0,0 +1,259 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+	"github.com/haigeek/douban-api-go/internal/book"
+)
+
+const introspectionQuery = `
+query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives { name description locations args { ...InputValue } }
+  }
+}
+fragment FullType on __Type {
+  kind name description
+  fields(includeDeprecated: true) {
+    name description
+    args { ...InputValue }
+    type { ...TypeRef }
+    isDeprecated deprecationReason
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+  possibleTypes { ...TypeRef }
+}
+fragment InputValue on __InputValue { name description type { ...TypeRef } defaultValue }
+fragment TypeRef on __Type {
+  kind name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name
+    ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } } }
+}`
+
+func testSchema(t *testing.T) *graphql.Schema {
+	t.Helper()
+	schema, err := newSchema(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("newSchema: %v", err)
+	}
+	return schema
+}
+
+type fakeUpstream struct {
+	fetches atomic.Int64
+	credits int
+}
+
+func (f *fakeUpstream) loaders() *loaders {
+	l := &loaders{}
+	l.remaining.Store(maxUpstreamRequests)
+	l.movie = NewLoader(budgeted(l, 1, func(_ context.Context, sid string) (movie.MovieInfo, error) {
+		f.fetches.Add(1)
+		if sid == "404" {
+			return movie.MovieInfo{}, movie.ErrNotFound
+		}
+		return movie.MovieInfo{SID: sid, Name: "Movie " + sid, IMDB: "tt" + sid}, nil
+	}))
+	l.celebrities = NewLoader(budgeted(l, 1, func(_ context.Context, sid string) ([]movie.Celebrity, error) {
+		f.fetches.Add(1)
+		credits := make([]movie.Celebrity, f.credits)
+		for i := range credits {
+			credits[i] = movie.Celebrity{ID: fmt.Sprint(i % 3), Name: fmt.Sprint("C", i), RoleType: "actor"}
+		}
+		return credits, nil
+	}))
+	l.photos = NewLoader(budgeted(l, 1, func(context.Context, string) ([]movie.Photo, error) {
+		f.fetches.Add(1)
+		return nil, nil
+	}))
+	l.celebrity = NewLoader(budgeted(l, 1, func(_ context.Context, id string) (movie.CelebrityInfo, error) {
+		f.fetches.Add(1)
+		return movie.CelebrityInfo{ID: id, Name: "Person " + id}, nil
+	}))
+	l.book = NewLoader(budgeted(l, 1, func(_ context.Context, id string) (book.DoubanBook, error) {
+		f.fetches.Add(1)
+		return book.DoubanBook{
+			ID:        id,
+			Title:     "Book " + id,
+			PageCount: 320,
+			Rating:    book.Rating{Average: 8.7},
+			Images:    book.Image{Large: "large.jpg"},
+			Tags:      []book.Tag{{Name: "sf"}, {Name: "classic"}},
+		}, nil
+	}))
+	return l
+}
+
+func exec(t *testing.T, f *fakeUpstream, query string, variables map[string]any) (string, []string) {
+	t.Helper()
+	ctx := context.WithValue(context.Background(), loadersKey{}, f.loaders())
+	resp := testSchema(t).Exec(ctx, query, "", variables)
+	var messages []string
+	for _, e := range resp.Errors {
+		messages = append(messages, e.Message)
+	}
+	return string(resp.Data), messages
+}
+
+func TestSchemaIntrospection(t *testing.T) {
+	data, errs := exec(t, &fakeUpstream{}, introspectionQuery, nil)
+	if len(errs) > 0 {
+		t.Fatalf("errors = %q", errs)
+	}
+	var result struct {
+		Schema struct {
+			QueryType struct{ Name string }
+			Types     []struct{ Name string }
+		} `json:"__schema"`
+	}
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if result.Schema.QueryType.Name != "Query" {
+		t.Fatalf("queryType = %q", result.Schema.QueryType.Name)
+	}
+	names := make(map[string]bool)
+	for _, typ := range result.Schema.Types {
+		names[typ.Name] = true
+	}
+	for _, want := range []string{"Movie", "Credit", "CelebrityProfile", "Photo", "MovieSummary", "MediaItem", "Top250Item", "Book"} {
+		if !names[want] {
+			t.Errorf("type %s missing from introspection", want)
+		}
+	}
+}
+
+func TestSchemaExecute(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		credits   int
+		want      string
+		fetches   int64
+	}{
+		{
+			name:    "movie with credits and deduplicated celebrities",
+			query:   `{ movie(id: "1") { id name imdb credits { name celebrity { name } } } }`,
+			credits: 4,
+			want:    `{"movie":{"id":"1","name":"Movie 1","imdb":"tt1","credits":[{"name":"C0","celebrity":{"name":"Person 0"}},{"name":"C1","celebrity":{"name":"Person 1"}},{"name":"C2","celebrity":{"name":"Person 2"}},{"name":"C3","celebrity":{"name":"Person 0"}}]}}`,
+			fetches: 5,
+		},
+		{
+			name:      "variables and aliases share one fetch",
+			query:     `query ($id: ID!) { a: movie(id: $id) { name } b: movie(id: $id) { id } }`,
+			variables: map[string]any{"id": "7"},
+			want:      `{"a":{"name":"Movie 7"},"b":{"id":"7"}}`,
+			fetches:   1,
+		},
+		{
+			name:    "book scalars are typed",
+			query:   `{ book(id: "2") { id title pageCount rating image tags } }`,
+			want:    `{"book":{"id":"2","title":"Book 2","pageCount":320,"rating":8.699999809265137,"image":"large.jpg","tags":["sf","classic"]}}`,
+			fetches: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeUpstream{credits: tt.credits}
+			ctx := context.WithValue(context.Background(), loadersKey{}, f.loaders())
+			resp := testSchema(t).Exec(ctx, tt.query, "", tt.variables)
+			if len(resp.Errors) > 0 {
+				t.Fatalf("errors = %v", resp.Errors)
+			}
+			if string(resp.Data) != tt.want {
+				t.Errorf("data = %s\nwant   %s", resp.Data, tt.want)
+			}
+			if n := f.fetches.Load(); n != tt.fetches {
+				t.Errorf("fetches = %d, want %d", n, tt.fetches)
+			}
+		})
+	}
+}
+
+func TestSchemaCapsCredits(t *testing.T) {
+	f := &fakeUpstream{credits: 60}
+	data, errs := exec(t, f, `{ movie(id: "1") { credits { id } } }`, nil)
+	if len(errs) > 0 {
+		t.Fatalf("errors = %q", errs)
+	}
+	var result struct {
+		Movie struct{ Credits []struct{ ID string } }
+	}
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if n := len(result.Movie.Credits); n != maxCredits {
+		t.Fatalf("credits = %d, want %d", n, maxCredits)
+	}
+}
+
+func TestSchemaRejectsBeforeResolving(t *testing.T) {
+	deep := "{ __schema { types { fields { type" + strings.Repeat(" { ofType", 12) + " { name }" + strings.Repeat(" }", 12) + " } } } }"
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"syntax error", `{ movie(id: "1") { name }`, "syntax error"},
+		{"unknown field", `{ movie(id: "1") { rank } }`, `Cannot query field "rank" on type "Movie".`},
+		{"missing argument", `{ movie { name } }`, `Field "movie" argument "id" of type "ID!" is required`},
+		{"wrong argument type", `{ hotMovies(limit: "ten") { id } }`, `Argument "limit" has invalid value "ten"`},
+		{"leaf selection required", `{ movie(id: "1") }`, `must have a selection of subfields`},
+		{"mutation", `mutation { movie(id: "1") { name } }`, "no mutations are offered"},
+		{"too deep", deep, "exceeds max depth"},
+		{"too long", "{ movie(id: \"1\") {" + strings.Repeat(" name", maxQueryLength/5) + " } }", "query length"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeUpstream{}
+			data, errs := exec(t, f, tt.query, nil)
+			if data != "" {
+				t.Errorf("data = %s, want none", data)
+			}
+			if !strings.Contains(strings.Join(errs, "\n"), tt.want) {
+				t.Errorf("errors = %q, want one containing %q", errs, tt.want)
+			}
+			if n := f.fetches.Load(); n != 0 {
+				t.Errorf("%d upstream fetches before the query was rejected", n)
+			}
+		})
+	}
+}
+
+func TestSchemaBudget(t *testing.T) {
+	f := &fakeUpstream{}
+	var fields []string
+	for i := 0; i < maxUpstreamRequests+10; i++ {
+		fields = append(fields, fmt.Sprintf(`m%d:movie(id:"%d"){id}`, i, i))
+	}
+	ctx := context.WithValue(context.Background(), loadersKey{}, f.loaders())
+	resp := testSchema(t).Exec(ctx, "{ "+strings.Join(fields, " ")+" }", "", nil)
+	if f.fetches.Load() > maxUpstreamRequests {
+		t.Fatalf("fetches = %d, budget %d", f.fetches.Load(), maxUpstreamRequests)
+	}
+	budgetErrors := 0
+	for _, e := range resp.Errors {
+		if errors.Is(e.ResolverError, errBudgetExceeded) {
+			budgetErrors++
+		}
+	}
+	if budgetErrors == 0 {
+		t.Fatalf("errors = %v, want budget errors", resp.Errors)
+	}
+}
@@ -34,9 +34,10 @@ var (
 
 type Client struct {
 	httpClient *http.Client
+	limiter    *limiter
 }
 
-func New(cookieValue string) (*Client, error) {
+func New(cookieValue string, rateLimit float64) (*Client, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, err
@@ -78,6 +79,7 @@ func New(cookieValue string) (*Client, error) {
 			Jar:       jar,
 			Transport: transport,
 		},
+		limiter: newLimiter(rateLimit),
 	}, nil
 }
 
@@ -104,6 +106,9 @@ func (c *Client) get(ctx context.Context, rawURL string, query map[string]string
 	req.Header.Set("Referer", refererHeader)
 	req.Header.Set("User-Agent", uaHeader)
 
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -172,6 +177,9 @@ func (c *Client) solveChallengeIfNeeded(ctx context.Context, resp *http.Response
 	req.Header.Set("Referer", resp.Request.URL.String())
 	req.Header.Set("Origin", "https://sec.douban.com")
 
+	if err := c.limiter.Wait(ctx); err != nil {
+		return false, err
+	}
 	chkResp, err := c.httpClient.Do(req)
 	if err != nil {
 		return false, err
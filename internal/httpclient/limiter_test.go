@@ -0,0 +1,93 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiterDisabled(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		l := newLimiter(rate)
+		if l != nil {
+			t.Fatalf("newLimiter(%v) = %v, want nil", rate, l)
+		}
+		start := time.Now()
+		for i := 0; i < 100; i++ {
+			if err := l.Wait(context.Background()); err != nil {
+				t.Fatalf("Wait on disabled limiter: %v", err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Fatalf("disabled limiter waited %v", elapsed)
+		}
+	}
+}
+
+func TestLimiterSpacesRequests(t *testing.T) {
+	l := newLimiter(50)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Fatalf("5 requests at 50/s took %v, want at least 80ms", elapsed)
+	}
+}
+
+func TestLimiterConcurrentWaiters(t *testing.T) {
+	l := newLimiter(100)
+	start := time.Now()
+	done := make(chan error, 6)
+	for i := 0; i < 6; i++ {
+		go func() { done <- l.Wait(context.Background()) }()
+	}
+	for i := 0; i < 6; i++ {
+		if err := <-done; err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("6 concurrent requests at 100/s took %v, want at least 50ms", elapsed)
+	}
+}
+
+func TestLimiterContextCanceled(t *testing.T) {
+	l := newLimiter(1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("first Wait: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait with expired context = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("canceled Wait returned after %v", elapsed)
+	}
+}
+
+func TestLimiterCanceledWaitReleasesSlot(t *testing.T) {
+	l := newLimiter(5)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("first Wait: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Wait with expired context = %v, want DeadlineExceeded", err)
+		}
+		cancel()
+	}
+	start := time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait after cancellations: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 300*time.Millisecond {
+		t.Fatalf("Wait after canceled waiters took %v, want about one interval", elapsed)
+	}
+}
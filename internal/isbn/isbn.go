@@ -0,0 +1,98 @@
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("invalid isbn")
+
+func Normalize(raw string) (string, error) {
+	code := clean(raw)
+	switch len(code) {
+	case 10:
+		if !Valid10(code) {
+			return "", ErrInvalid
+		}
+		return To13(code), nil
+	case 13:
+		if !Valid13(code) {
+			return "", ErrInvalid
+		}
+		return code, nil
+	default:
+		return "", ErrInvalid
+	}
+}
+
+func Valid10(code string) bool {
+	if len(code) != 10 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := code[i]
+		var v int
+		switch {
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		case c == 'X' && i == 9:
+			v = 10
+		default:
+			return false
+		}
+		sum += v * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+func Valid13(code string) bool {
+	if len(code) != 13 || !digitsOnly(code) {
+		return false
+	}
+	if !strings.HasPrefix(code, "978") && !strings.HasPrefix(code, "979") {
+		return false
+	}
+	return checkDigit13(code[:12]) == code[12]
+}
+
+func To13(code10 string) string {
+	base := "978" + code10[:9]
+	return base + string(checkDigit13(base))
+}
+
+func checkDigit13(code12 string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		v := int(code12[i] - '0')
+		if i%2 == 1 {
+			v *= 3
+		}
+		sum += v
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func clean(raw string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(strings.TrimSpace(raw)) {
+		switch {
+		case r == '-' || r == ' ' || r == '\u00a0':
+			continue
+		case r >= '0' && r <= '9' || r == 'X':
+			b.WriteRune(r)
+		default:
+			return ""
+		}
+	}
+	return b.String()
+}
+
+func digitsOnly(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package isbn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "9787506365437", want: "9787506365437"},
+		{raw: "978-7-5063-6543-7", want: "9787506365437"},
+		{raw: " 978 7 5063 6543 7 ", want: "9787506365437"},
+		{raw: "978 7506365437", want: "9787506365437"},
+		{raw: "0306406152", want: "9780306406157"},
+		{raw: "0-306-40615-2", want: "9780306406157"},
+		{raw: "080442957X", want: "9780804429573"},
+		{raw: "080442957x", want: "9780804429573"},
+		{raw: "0-439-42089-X", want: "9780439420891"},
+		{raw: "9791032305690", want: "9791032305690"},
+		{raw: "9787506365436", wantErr: true},
+		{raw: "0306406153", wantErr: true},
+		{raw: "0804429579", wantErr: true},
+		{raw: "08044295X7", wantErr: true},
+		{raw: "9770306406157", wantErr: true},
+		{raw: "978750636543", wantErr: true},
+		{raw: "97875063654370", wantErr: true},
+		{raw: "ISBN9787506365437", wantErr: true},
+		{raw: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Normalize(tt.raw)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalid) {
+				t.Errorf("Normalize(%q) = %q, %v; want ErrInvalid", tt.raw, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Normalize(%q) = %q, %v; want %q", tt.raw, got, err, tt.want)
+		}
+	}
+}
+
+func TestValid10(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"0306406152", true},
+		{"080442957X", true},
+		{"043942089X", true},
+		{"0306406151", false},
+		{"X306406152", false},
+		{"080442957x", false},
+		{"030640615", false},
+	}
+	for _, tt := range tests {
+		if got := Valid10(tt.code); got != tt.want {
+			t.Errorf("Valid10(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestValid13(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"9787506365437", true},
+		{"9780306406157", true},
+		{"9780306406158", false},
+		{"9770306406157", false},
+		{"978030640615X", false},
+		{"978030640615", false},
+	}
+	for _, tt := range tests {
+		if got := Valid13(tt.code); got != tt.want {
+			t.Errorf("Valid13(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestTo13(t *testing.T) {
+	tests := []struct {
+		code10 string
+		want   string
+	}{
+		{"0306406152", "9780306406157"},
+		{"080442957X", "9780804429573"},
+		{"043942089X", "9780439420891"},
+	}
+	for _, tt := range tests {
+		if got := To13(tt.code10); got != tt.want {
+			t.Errorf("To13(%q) = %q, want %q", tt.code10, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+)
+
+var (
+	ErrUnknownSubject  = errors.New("unknown subject")
+	ErrInvalidCategory = errors.New("invalid category")
+	ErrInvalidType     = errors.New("invalid type")
+)
+
+var catalogDefaults = map[string][2]string{
+	"movie": {"热门", "全部"},
+	"tv":    {"tv", "tv"},
+}
+
+var catalogSubjects = []string{"movie", "tv"}
+
+var catalogFallback = map[string][]CatalogCategory{
+	"movie": {
+		{Category: "热门", Title: "热门电影", Types: catalogOptions("全部", "华语", "欧美", "韩国", "日本")},
+		{Category: "最新", Title: "最新电影", Types: catalogOptions("全部", "华语", "欧美", "韩国", "日本")},
+		{Category: "豆瓣高分", Title: "豆瓣高分", Types: catalogOptions("全部", "华语", "欧美", "韩国", "日本")},
+		{Category: "冷门佳片", Title: "冷门佳片", Types: catalogOptions("全部", "华语", "欧美", "韩国", "日本")},
+	},
+	"tv": {
+		{Category: "tv", Title: "热门剧集", Types: catalogOptions("tv", "tv_domestic", "tv_american", "tv_japanese", "tv_korean", "tv_animation", "tv_documentary")},
+		{Category: "show", Title: "热门综艺", Types: catalogOptions("show", "show_domestic", "show_foreign")},
+	},
+}
+
+func catalogOptions(types ...string) []HotMediaOption {
+	options := make([]HotMediaOption, 0, len(types))
+	for _, t := range types {
+		options = append(options, HotMediaOption{Type: t, Title: t})
+	}
+	return options
+}
+
+func (s *Service) Categories(ctx context.Context) ([]Catalog, error) {
+	list := make([]Catalog, 0, len(catalogSubjects))
+	for _, subject := range catalogSubjects {
+		catalog, err := s.Catalog(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, catalog)
+	}
+	return list, nil
+}
+
+func (s *Service) Catalog(ctx context.Context, subject string) (Catalog, error) {
+	defaults, ok := catalogDefaults[subject]
+	if !ok {
+		return Catalog{}, ErrUnknownSubject
+	}
+	if v, ok := s.catalogCache.Get(subject); ok {
+		return v, nil
+	}
+
+	resp, err := s.RecentHot(ctx, subject, defaults[0], defaults[1], 0, 1)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	catalog := Catalog{Subject: subject, Categories: make([]CatalogCategory, 0, len(resp.Tags))}
+	for _, tag := range resp.Tags {
+		types := make([]HotMediaOption, 0, len(tag.Types))
+		seen := make(map[string]bool)
+		options := tag.Types
+		if tag.Selected || tag.Category == resp.Category {
+			options = append(append([]HotMediaOption{}, tag.Types...), resp.RecommendTags...)
+		}
+		for _, option := range options {
+			if option.Type == "" || seen[option.Type] {
+				continue
+			}
+			seen[option.Type] = true
+			types = append(types, HotMediaOption{Type: option.Type, Title: option.Title})
+		}
+		catalog.Categories = append(catalog.Categories, CatalogCategory{
+			Category: tag.Category,
+			Title:    tag.Title,
+			Types:    types,
+		})
+	}
+
+	if len(catalog.Categories) == 0 {
+		log.Printf("catalog %s: upstream returned no categories, using the built-in list", subject)
+		return Catalog{Subject: subject, Categories: catalogFallback[subject]}, nil
+	}
+	s.catalogCache.Add(subject, catalog)
+	return catalog, nil
+}
+
+func (s *Service) RecentHotChecked(ctx context.Context, subject, category, mediaType string, start, limit int) (HotMediaResponse, error) {
+	catalog, err := s.Catalog(ctx, subject)
+	if err != nil {
+		return HotMediaResponse{}, err
+	}
+	defaults := catalogDefaults[subject]
+	if category == "" {
+		category = defaults[0]
+	}
+	if mediaType == "" {
+		mediaType = defaults[1]
+	}
+	if err := catalog.validate(category, mediaType); err != nil {
+		return HotMediaResponse{}, err
+	}
+	return s.RecentHot(ctx, subject, category, mediaType, start, limit)
+}
+
+func (c Catalog) validate(category, mediaType string) error {
+	for _, cat := range c.Categories {
+		if cat.Category != category {
+			continue
+		}
+		if len(cat.Types) == 0 {
+			return nil
+		}
+		for _, option := range cat.Types {
+			if option.Type == mediaType {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: %s", ErrInvalidType, mediaType)
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidCategory, category)
+}
@@ -0,0 +1,41 @@
+package media
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCatalogValidate(t *testing.T) {
+	catalog := Catalog{Subject: "movie", Categories: []CatalogCategory{
+		{Category: "热门", Types: catalogOptions("全部", "华语")},
+		{Category: "豆瓣高分"},
+	}}
+	tests := []struct {
+		catalog   Catalog
+		category  string
+		mediaType string
+		want      error
+	}{
+		{catalog, "热门", "华语", nil},
+		{catalog, "热门", "欧美", ErrInvalidType},
+		{catalog, "豆瓣高分", "任意", nil},
+		{catalog, "冷门", "全部", ErrInvalidCategory},
+		{Catalog{Subject: "movie"}, "热门", "全部", ErrInvalidCategory},
+	}
+	for _, tt := range tests {
+		err := tt.catalog.validate(tt.category, tt.mediaType)
+		if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+			t.Errorf("validate(%q, %q) with %d categories = %v, want %v", tt.category, tt.mediaType, len(tt.catalog.Categories), err, tt.want)
+		}
+	}
+}
+
+func TestCatalogFallbackCoversDefaults(t *testing.T) {
+	for _, subject := range catalogSubjects {
+		defaults := catalogDefaults[subject]
+		fallback := Catalog{Subject: subject, Categories: catalogFallback[subject]}
+		if err := fallback.validate(defaults[0], defaults[1]); err != nil {
+			t.Errorf("fallback catalog for %s rejects its defaults %v: %v", subject, defaults, err)
+		}
+	}
+}
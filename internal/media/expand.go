@@ -0,0 +1,85 @@
+package media
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+const (
+	expandDetail      = "detail"
+	expandConcurrency = 4
+)
+
+func (s *Service) ExpandHotMedia(ctx context.Context, result *HotMediaResponse) error {
+	ids := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		ids = append(ids, item.ID)
+	}
+	details, err := s.details(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range result.Items {
+		result.Items[i].Detail = details[i]
+	}
+	return nil
+}
+
+func (s *Service) ExpandTop250(ctx context.Context, result *Top250Response) error {
+	ids := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		ids = append(ids, item.ID)
+	}
+	details, err := s.details(ctx, ids)
+	if err != nil {
+		return err
+	}
+	items := make([]Top250Item, len(result.Items))
+	for i, item := range result.Items {
+		item.Detail = details[i]
+		items[i] = item
+	}
+	result.Items = items
+	return nil
+}
+
+func (s *Service) details(ctx context.Context, ids []string) ([]*movie.MovieInfo, error) {
+	details := make([]*movie.MovieInfo, len(ids))
+	sem := make(chan struct{}, expandConcurrency)
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		if id == "" {
+			continue
+		}
+		if v, ok := s.detailCache.Get(id); ok {
+			info := v
+			details[i] = &info
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			info, err := s.movie.GetMovieInfo(ctx, id, "")
+			if err != nil {
+				log.Printf("expand detail %s failed: %v", id, err)
+				return
+			}
+			s.detailCache.Add(id, info)
+			details[i] = &info
+		}(i, id)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
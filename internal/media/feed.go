@@ -0,0 +1,188 @@
+package media
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/haigeek/douban-api-go/internal/feed"
+)
+
+const (
+	subjectURL = "https://movie.douban.com/subject/%s/"
+	feedAuthor = "豆瓣"
+)
+
+var feedPresentationParams = []string{"format", "start", "limit", "expand"}
+
+func (s *Service) hotMediaFeed(title, feedID, feedURL string, result HotMediaResponse) feed.Feed {
+	ids := make([]string, len(result.Items))
+	for i, it := range result.Items {
+		ids[i] = it.ID
+	}
+	seen := s.firstSeen(ids, time.Now())
+	updated := time.Time{}
+	items := make([]feed.Item, 0, len(result.Items))
+	for i, it := range result.Items {
+		desc := make([]string, 0, 2)
+		if it.Rating != nil && it.Rating.Value > 0 {
+			desc = append(desc, fmt.Sprintf("评分 %.1f（%d 人评价）", it.Rating.Value, it.Rating.Count))
+		} else if it.NullRatingReason != "" {
+			desc = append(desc, it.NullRatingReason)
+		}
+		if it.CardSubtitle != "" {
+			desc = append(desc, it.CardSubtitle)
+		}
+		image := ""
+		if it.Pic != nil {
+			image = it.Pic.Large
+			if image == "" {
+				image = it.Pic.Normal
+			}
+		}
+		if seen[i].After(updated) {
+			updated = seen[i]
+		}
+		items = append(items, feed.Item{
+			ID:          subjectGUID(it.ID),
+			Title:       it.Title,
+			Link:        fmt.Sprintf(subjectURL, it.ID),
+			Description: strings.Join(desc, "\n"),
+			Image:       image,
+			Updated:     seen[i],
+		})
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	return feed.Feed{
+		ID:          feedID,
+		Author:      feedAuthor,
+		Title:       title,
+		Link:        "https://movie.douban.com/",
+		FeedURL:     feedURL,
+		Description: title,
+		Updated:     updated,
+		Items:       items,
+	}
+}
+
+func (s *Service) top250Feed(feedID, feedURL string, result Top250Response) feed.Feed {
+	ids := make([]string, len(result.Items))
+	for i, it := range result.Items {
+		ids[i] = it.ID
+	}
+	seen := s.firstSeen(ids, time.Now())
+	updated := time.Time{}
+	items := make([]feed.Item, 0, len(result.Items))
+	for i, it := range result.Items {
+		desc := []string{fmt.Sprintf("No.%d 评分 %.1f（%d 人评价）", it.Rank, it.Rating, it.Votes)}
+		if it.Quote != "" {
+			desc = append(desc, it.Quote)
+		}
+		title := it.Title
+		if it.Year != "" {
+			title = fmt.Sprintf("%s (%s)", it.Title, it.Year)
+		}
+		if seen[i].After(updated) {
+			updated = seen[i]
+		}
+		items = append(items, feed.Item{
+			ID:          subjectGUID(it.ID),
+			Title:       title,
+			Link:        fmt.Sprintf(subjectURL, it.ID),
+			Description: strings.Join(desc, "\n"),
+			Image:       it.Pic,
+			Updated:     seen[i],
+		})
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	return feed.Feed{
+		ID:          feedID,
+		Author:      feedAuthor,
+		Title:       "豆瓣电影 Top 250",
+		Link:        top250URL,
+		FeedURL:     feedURL,
+		Description: "豆瓣电影 Top 250",
+		Updated:     updated,
+		Items:       items,
+	}
+}
+
+func (s *Service) firstSeen(ids []string, now time.Time) []time.Time {
+	s.seenMu.Lock()
+	defer s.seenMu.Unlock()
+	seen := make([]time.Time, len(ids))
+	added := make(map[string]string)
+	stamp := now.UTC().Truncate(time.Second)
+	for i, id := range ids {
+		if raw, ok := s.seenStore.Get(id); ok {
+			if t, err := time.Parse(time.RFC3339, raw); err == nil {
+				seen[i] = t
+				continue
+			}
+		}
+		if raw, ok := added[id]; ok {
+			seen[i], _ = time.Parse(time.RFC3339, raw)
+			continue
+		}
+		seen[i] = stamp
+		added[id] = stamp.Format(time.RFC3339)
+	}
+	if len(added) > 0 {
+		if err := s.seenStore.SetMany(added); err != nil {
+			log.Printf("save feed first-seen times failed: %v", err)
+		}
+	}
+	return seen
+}
+
+func subjectGUID(id string) string {
+	return "tag:douban.com,2005:subject/" + id
+}
+
+func feedFormat(c *gin.Context) string {
+	return feed.Negotiate(c.Query("format"), c.GetHeader("Accept"))
+}
+
+func writeFeed(c *gin.Context, format string, f feed.Feed) {
+	contentType, body, err := feed.Render(f, format)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.Data(http.StatusOK, contentType, body)
+}
+
+func requestURL(c *gin.Context) string {
+	return requestBase(c) + c.Request.RequestURI
+}
+
+func canonicalURL(c *gin.Context) string {
+	query := c.Request.URL.Query()
+	for _, key := range feedPresentationParams {
+		query.Del(key)
+	}
+	u := requestBase(c) + c.Request.URL.Path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+func requestBase(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return fmt.Sprintf("%s://%s", scheme, c.Request.Host)
+}
@@ -0,0 +1,59 @@
+package media
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/haigeek/douban-api-go/internal/store"
+)
+
+func TestCanonicalURL(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/v2/media/top250?format=atom&start=25&limit=25", "http://example.com/v2/media/top250"},
+		{"/v2/media/hot/tv?expand=detail&format=rss", "http://example.com/v2/media/hot/tv"},
+		{"/v2/media/browse?tag=%E7%83%AD%E9%97%A8&type=movie&format=json&sort=time", "http://example.com/v2/media/browse?sort=time&tag=%E7%83%AD%E9%97%A8&type=movie"},
+	}
+	for _, tt := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", tt.target, nil)
+		if got := canonicalURL(c); got != tt.want {
+			t.Errorf("canonicalURL(%s) = %s, want %s", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestFirstSeenPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feed_seen.json")
+	open := func() *Service {
+		st, err := store.Open(path)
+		if err != nil {
+			t.Fatalf("store.Open: %v", err)
+		}
+		return &Service{seenStore: st}
+	}
+
+	first := time.Date(2024, 5, 6, 7, 8, 9, 500, time.UTC)
+	seen := open().firstSeen([]string{"1", "2", "1"}, first)
+	want := first.Truncate(time.Second)
+	for i, ts := range seen {
+		if !ts.Equal(want) {
+			t.Fatalf("seen[%d] = %v, want %v", i, ts, want)
+		}
+	}
+
+	later := first.Add(time.Hour)
+	seen = open().firstSeen([]string{"2", "3"}, later)
+	if !seen[0].Equal(want) {
+		t.Errorf("reopened store seen[2] = %v, want %v", seen[0], want)
+	}
+	if !seen[1].Equal(later.Truncate(time.Second)) {
+		t.Errorf("new id seen = %v, want %v", seen[1], later)
+	}
+}
@@ -1,6 +1,8 @@
 package media
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -13,11 +15,12 @@ const (
 )
 
 type Handlers struct {
-	service *Service
+	service   *Service
+	snapshots *Snapshotter
 }
 
-func NewHandlers(service *Service) *Handlers {
-	return &Handlers{service: service}
+func NewHandlers(service *Service, snapshots *Snapshotter) *Handlers {
+	return &Handlers{service: service, snapshots: snapshots}
 }
 
 func (h *Handlers) HotTV(c *gin.Context) {
@@ -30,7 +33,7 @@ func (h *Handlers) HotTV(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, result)
+	h.writeHotMedia(c, "豆瓣热门电视剧", result)
 }
 
 func (h *Handlers) HotMovie(c *gin.Context) {
@@ -43,7 +46,7 @@ func (h *Handlers) HotMovie(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, result)
+	h.writeHotMedia(c, "豆瓣热门电影", result)
 }
 
 func (h *Handlers) LatestMovie(c *gin.Context) {
@@ -56,7 +59,7 @@ func (h *Handlers) LatestMovie(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, result)
+	h.writeHotMedia(c, "豆瓣最新电影", result)
 }
 
 func (h *Handlers) HighRatingMovie(c *gin.Context) {
@@ -69,6 +72,138 @@ func (h *Handlers) HighRatingMovie(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
+	h.writeHotMedia(c, "豆瓣高分电影", result)
+}
+
+func (h *Handlers) Browse(c *gin.Context) {
+	mediaType := c.DefaultQuery("type", "movie")
+	if mediaType != "movie" && mediaType != "tv" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid type"})
+		return
+	}
+	sort := c.DefaultQuery("sort", "recommend")
+	if sort != "recommend" && sort != "time" && sort != "rank" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid sort"})
+		return
+	}
+	tag := c.DefaultQuery("tag", "热门")
+
+	start, limit, ok := parsePageParams(c)
+	if !ok {
+		return
+	}
+	result, err := h.service.Browse(c.Request.Context(), mediaType, tag, sort, start, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	h.writeHotMedia(c, fmt.Sprintf("豆瓣标签 %s", tag), result)
+}
+
+func (h *Handlers) RecentHot(c *gin.Context) {
+	start, limit, ok := parsePageParams(c)
+	if !ok {
+		return
+	}
+	result, err := h.service.RecentHotChecked(c.Request.Context(), c.Param("subject"), c.Query("category"), c.Query("type"), start, limit)
+	if err != nil {
+		if errors.Is(err, ErrUnknownSubject) {
+			c.JSON(http.StatusNotFound, gin.H{"message": err.Error()})
+			return
+		}
+		if errors.Is(err, ErrInvalidCategory) || errors.Is(err, ErrInvalidType) {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	h.writeHotMedia(c, fmt.Sprintf("豆瓣 %s %s", result.Category, result.Type), result)
+}
+
+func (h *Handlers) Categories(c *gin.Context) {
+	result, err := h.service.Categories(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) Top250(c *gin.Context) {
+	start, limit, ok := parsePageParams(c)
+	if !ok {
+		return
+	}
+	result, err := h.service.Top250(c.Request.Context(), start, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	if c.Query("expand") == expandDetail {
+		if err := h.service.ExpandTop250(c.Request.Context(), &result); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+	if format := feedFormat(c); format != "" {
+		writeFeed(c, format, h.service.top250Feed(canonicalURL(c), requestURL(c), result))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) SnapshotLists(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"lists": h.snapshots.Lists()})
+}
+
+func (h *Handlers) SnapshotIDs(c *gin.Context) {
+	list := c.Param("list")
+	ids, err := h.snapshots.IDs(list)
+	if err != nil {
+		writeSnapshotError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"list": list, "ids": ids})
+}
+
+func (h *Handlers) Snapshot(c *gin.Context) {
+	result, err := h.snapshots.Load(c.Param("list"), c.Param("id"))
+	if err != nil {
+		writeSnapshotError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) SnapshotDiff(c *gin.Context) {
+	result, err := h.snapshots.Diff(c.Param("list"), c.Query("from"), c.DefaultQuery("to", snapshotLatest))
+	if err != nil {
+		writeSnapshotError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func writeSnapshotError(c *gin.Context, err error) {
+	if errors.Is(err, ErrUnknownList) || errors.Is(err, ErrSnapshotNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+}
+
+func (h *Handlers) writeHotMedia(c *gin.Context, title string, result HotMediaResponse) {
+	if c.Query("expand") == expandDetail {
+		if err := h.service.ExpandHotMedia(c.Request.Context(), &result); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+	if format := feedFormat(c); format != "" {
+		writeFeed(c, format, h.service.hotMediaFeed(title, canonicalURL(c), requestURL(c), result))
+		return
+	}
 	c.JSON(http.StatusOK, result)
 }
 
@@ -4,18 +4,41 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
 	"github.com/haigeek/douban-api-go/internal/httpclient"
+	"github.com/haigeek/douban-api-go/internal/store"
 )
 
-const recentHotAPI = "https://m.douban.com/rexxar/api/v2/subject/recent_hot/%s"
+const (
+	recentHotAPI      = "https://m.douban.com/rexxar/api/v2/subject/recent_hot/%s"
+	searchSubjectsAPI = "https://movie.douban.com/j/search_subjects"
+)
 
 type Service struct {
-	client *httpclient.Client
+	client       *httpclient.Client
+	movie        *movie.Service
+	top250Cache  *expirable.LRU[string, []Top250Item]
+	catalogCache *expirable.LRU[string, Catalog]
+	detailCache  *expirable.LRU[string, movie.MovieInfo]
+	seenMu       sync.Mutex
+	seenStore    *store.Store
 }
 
-func NewService(client *httpclient.Client) *Service {
-	return &Service{client: client}
+func NewService(client *httpclient.Client, movieService *movie.Service, seenStore *store.Store) *Service {
+	return &Service{
+		client:       client,
+		movie:        movieService,
+		top250Cache:  expirable.NewLRU[string, []Top250Item](1, nil, 6*time.Hour),
+		catalogCache: expirable.NewLRU[string, Catalog](len(catalogSubjects), nil, 6*time.Hour),
+		detailCache:  expirable.NewLRU[string, movie.MovieInfo](500, nil, 30*time.Minute),
+		seenStore:    seenStore,
+	}
 }
 
 func (s *Service) RecentHot(ctx context.Context, subject, category, mediaType string, start, limit int) (HotMediaResponse, error) {
@@ -37,6 +60,52 @@ func (s *Service) RecentHot(ctx context.Context, subject, category, mediaType st
 	return out, nil
 }
 
+func (s *Service) Browse(ctx context.Context, mediaType, tag, sort string, start, limit int) (HotMediaResponse, error) {
+	resp, err := s.client.Get(ctx, searchSubjectsAPI, map[string]string{
+		"type":       mediaType,
+		"tag":        tag,
+		"sort":       sort,
+		"page_start": fmt.Sprintf("%d", start),
+		"page_limit": fmt.Sprintf("%d", limit),
+	}, true)
+	if err != nil {
+		return HotMediaResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	var raw searchSubjectsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return HotMediaResponse{}, err
+	}
+
+	items := make([]HotMediaItem, 0, len(raw.Subjects))
+	for _, subject := range raw.Subjects {
+		playable := subject.Playable
+		item := HotMediaItem{
+			ID:           subject.ID,
+			Type:         mediaType,
+			Title:        subject.Title,
+			URL:          subject.URL,
+			Playable:     &playable,
+			EpisodesInfo: subject.EpisodesInfo,
+		}
+		if v, err := strconv.ParseFloat(subject.Rate, 64); err == nil {
+			item.Rating = &HotMediaRating{Max: 10, Value: v, StarCount: v / 2}
+		}
+		if subject.Cover != "" {
+			item.Pic = &HotMediaPic{Large: subject.Cover, Normal: subject.Cover}
+		}
+		items = append(items, item)
+	}
+
+	return HotMediaResponse{
+		Category: tag,
+		Type:     mediaType,
+		Total:    len(items),
+		Items:    items,
+	}, nil
+}
+
 func (s *Service) HotTV(ctx context.Context, start, limit int) (HotMediaResponse, error) {
 	return s.RecentHot(ctx, "tv", "tv", "tv", start, limit)
 }
@@ -0,0 +1,247 @@
+package media
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	snapshotIDLayout = "20060102T150405Z"
+	snapshotLatest   = "latest"
+)
+
+var (
+	ErrUnknownList      = errors.New("unknown snapshot list")
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+var snapshotSources = map[string]func(*Service, context.Context, int, int) (HotMediaResponse, error){
+	"hot-tv":            (*Service).HotTV,
+	"hot-movie":         (*Service).HotMovie,
+	"latest-movie":      (*Service).LatestMovie,
+	"high-rating-movie": (*Service).HighRatingMovie,
+}
+
+type Snapshotter struct {
+	service *Service
+	dir     string
+	lists   []string
+}
+
+func NewSnapshotter(service *Service, dir string, lists []string) *Snapshotter {
+	valid := make([]string, 0, len(lists))
+	for _, list := range lists {
+		list = strings.TrimSpace(list)
+		if _, ok := snapshotSources[list]; ok {
+			valid = append(valid, list)
+		} else if list != "" {
+			log.Printf("ignore unknown snapshot list %q", list)
+		}
+	}
+	return &Snapshotter{service: service, dir: dir, lists: valid}
+}
+
+func (s *Snapshotter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.takeAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Snapshotter) takeAll(ctx context.Context) {
+	for _, list := range s.lists {
+		if _, err := s.Take(ctx, list); err != nil {
+			log.Printf("snapshot %s failed: %v", list, err)
+		}
+	}
+}
+
+func (s *Snapshotter) Take(ctx context.Context, list string) (Snapshot, error) {
+	source, ok := snapshotSources[list]
+	if !ok {
+		return Snapshot{}, ErrUnknownList
+	}
+	result, err := source(s.service, ctx, 0, maxLimit)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	now := time.Now().UTC()
+	snapshot := Snapshot{
+		ID:      now.Format(snapshotIDLayout),
+		List:    list,
+		TakenAt: now,
+		Total:   len(result.Items),
+		Items:   result.Items,
+	}
+
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	dir := filepath.Join(s.dir, list)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, snapshot.ID+".json"), raw, 0o644); err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+func (s *Snapshotter) Lists() []string {
+	return s.lists
+}
+
+func (s *Snapshotter) IDs(list string) ([]string, error) {
+	if _, ok := snapshotSources[list]; !ok {
+		return nil, ErrUnknownList
+	}
+	entries, err := os.ReadDir(filepath.Join(s.dir, list))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(name, ".json"))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (s *Snapshotter) Load(list, id string) (Snapshot, error) {
+	ids, err := s.IDs(list)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if id == "" || id == snapshotLatest {
+		if len(ids) == 0 {
+			return Snapshot{}, ErrSnapshotNotFound
+		}
+		id = ids[len(ids)-1]
+	}
+	if _, err := time.Parse(snapshotIDLayout, id); err != nil {
+		return Snapshot{}, ErrSnapshotNotFound
+	}
+
+	raw, err := os.ReadFile(filepath.Join(s.dir, list, id+".json"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Snapshot{}, ErrSnapshotNotFound
+		}
+		return Snapshot{}, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+func (s *Snapshotter) Diff(list, fromID, toID string) (SnapshotDiff, error) {
+	to, err := s.Load(list, toID)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	if fromID == "" {
+		ids, err := s.IDs(list)
+		if err != nil {
+			return SnapshotDiff{}, err
+		}
+		idx := sort.SearchStrings(ids, to.ID)
+		if idx == 0 {
+			return SnapshotDiff{}, ErrSnapshotNotFound
+		}
+		fromID = ids[idx-1]
+	}
+	from, err := s.Load(list, fromID)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+	return diffSnapshots(from, to), nil
+}
+
+func diffSnapshots(from, to Snapshot) SnapshotDiff {
+	diff := SnapshotDiff{
+		List:          to.List,
+		From:          from.ID,
+		To:            to.ID,
+		Entered:       []SnapshotDiffItem{},
+		Dropped:       []SnapshotDiffItem{},
+		Moved:         []SnapshotRankMove{},
+		RatingChanged: []SnapshotRatingMove{},
+	}
+
+	before := make(map[string]int, len(from.Items))
+	for i, item := range from.Items {
+		before[item.ID] = i
+	}
+	after := make(map[string]bool, len(to.Items))
+
+	for i, item := range to.Items {
+		after[item.ID] = true
+		j, ok := before[item.ID]
+		if !ok {
+			diff.Entered = append(diff.Entered, SnapshotDiffItem{ID: item.ID, Title: item.Title, Rank: i + 1})
+			continue
+		}
+		if i != j {
+			diff.Moved = append(diff.Moved, SnapshotRankMove{
+				ID:    item.ID,
+				Title: item.Title,
+				From:  j + 1,
+				To:    i + 1,
+				Delta: j - i,
+			})
+		}
+		oldRating := ratingValue(from.Items[j])
+		newRating := ratingValue(item)
+		if oldRating != newRating {
+			diff.RatingChanged = append(diff.RatingChanged, SnapshotRatingMove{
+				ID:    item.ID,
+				Title: item.Title,
+				From:  oldRating,
+				To:    newRating,
+				Delta: math.Round((newRating-oldRating)*10) / 10,
+			})
+		}
+	}
+
+	for i, item := range from.Items {
+		if !after[item.ID] {
+			diff.Dropped = append(diff.Dropped, SnapshotDiffItem{ID: item.ID, Title: item.Title, Rank: i + 1})
+		}
+	}
+	return diff
+}
+
+func ratingValue(item HotMediaItem) float64 {
+	if item.Rating == nil {
+		return 0
+	}
+	return item.Rating.Value
+}
@@ -0,0 +1,144 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	top250URL      = "https://movie.douban.com/top250"
+	top250PageSize = 25
+	top250Total    = 250
+	top250CacheKey = "top250"
+)
+
+var ErrTop250Incomplete = errors.New("incomplete top250 list")
+
+var (
+	reTop250ID    = regexp.MustCompile(`/subject/([0-9]+)`)
+	reTop250Year  = regexp.MustCompile(`((?:19|20)[0-9]{2})`)
+	reTop250Votes = regexp.MustCompile(`([0-9]+)\s*人评价`)
+)
+
+func (s *Service) Top250(ctx context.Context, start, limit int) (Top250Response, error) {
+	items, err := s.top250Items(ctx)
+	if err != nil {
+		return Top250Response{}, err
+	}
+
+	end := start + limit
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+	return Top250Response{
+		Total: len(items),
+		Items: items[start:end],
+	}, nil
+}
+
+func (s *Service) top250Items(ctx context.Context) ([]Top250Item, error) {
+	if v, ok := s.top250Cache.Get(top250CacheKey); ok {
+		return v, nil
+	}
+
+	items := make([]Top250Item, 0, top250Total)
+	for start := 0; start < top250Total; start += top250PageSize {
+		page, err := s.fetchTop250Page(ctx, start)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			return nil, fmt.Errorf("%w: page at %d is empty", ErrTop250Incomplete, start)
+		}
+		items = append(items, page...)
+	}
+	if len(items) < top250Total {
+		return nil, fmt.Errorf("%w: got %d of %d items", ErrTop250Incomplete, len(items), top250Total)
+	}
+
+	s.top250Cache.Add(top250CacheKey, items)
+	return items, nil
+}
+
+func (s *Service) fetchTop250Page(ctx context.Context, start int) ([]Top250Item, error) {
+	resp, err := s.client.Get(ctx, top250URL, map[string]string{
+		"start":  strconv.Itoa(start),
+		"filter": "",
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseTop250Page(doc), nil
+}
+
+func parseTop250Page(doc *goquery.Document) []Top250Item {
+	items := make([]Top250Item, 0, top250PageSize)
+	doc.Find("ol.grid_view > li").Each(func(_ int, li *goquery.Selection) {
+		rank, _ := strconv.Atoi(strings.TrimSpace(li.Find("div.pic em").Text()))
+		href, _ := li.Find("div.hd a").Attr("href")
+		pic, _ := li.Find("div.pic img").Attr("src")
+
+		titles := li.Find("div.hd span.title")
+		title := cleanTop250Title(titles.First().Text())
+		originalTitle := ""
+		if titles.Length() > 1 {
+			originalTitle = cleanTop250Title(titles.Eq(1).Text())
+		}
+		otherTitles := make([]string, 0)
+		for _, other := range strings.Split(li.Find("div.hd span.other").Text(), "/") {
+			if v := cleanTop250Title(other); v != "" {
+				otherTitles = append(otherTitles, v)
+			}
+		}
+
+		rating, _ := strconv.ParseFloat(strings.TrimSpace(li.Find("span.rating_num").Text()), 64)
+		votes, _ := strconv.Atoi(captureFirst(reTop250Votes, li.Find("div.star span").Last().Text()))
+
+		meta := li.Find("div.bd p").First().Text()
+		lines := strings.Split(strings.TrimSpace(meta), "\n")
+		year := captureFirst(reTop250Year, lines[len(lines)-1])
+
+		items = append(items, Top250Item{
+			Rank:          rank,
+			ID:            captureFirst(reTop250ID, href),
+			Title:         title,
+			OriginalTitle: originalTitle,
+			OtherTitles:   otherTitles,
+			Year:          year,
+			Rating:        rating,
+			Votes:         votes,
+			Quote:         strings.TrimSpace(li.Find("p.quote span").Text()),
+			Pic:           strings.TrimSpace(pic),
+			URL:           strings.TrimSpace(href),
+		})
+	})
+	return items
+}
+
+func cleanTop250Title(s string) string {
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s), "/"))
+}
+
+func captureFirst(re *regexp.Regexp, text string) string {
+	m := re.FindStringSubmatch(text)
+	if len(m) < 2 {
+		return ""
+	}
+	return strings.TrimSpace(m[1])
+}
@@ -1,6 +1,11 @@
 package media
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
 
 type HotMediaResponse struct {
 	Category      string           `json:"category"`
@@ -25,19 +30,20 @@ type HotMediaOption struct {
 }
 
 type HotMediaItem struct {
-	ID               string          `json:"id"`
-	Type             string          `json:"type"`
-	Title            string          `json:"title"`
-	Year             string          `json:"year,omitempty"`
-	URI              string          `json:"uri,omitempty"`
-	URL              string          `json:"url,omitempty"`
-	CardSubtitle     string          `json:"card_subtitle,omitempty"`
-	Rating           *HotMediaRating `json:"rating,omitempty"`
-	Pic              *HotMediaPic    `json:"pic,omitempty"`
-	Playable         *bool           `json:"playable,omitempty"`
-	NullRatingReason string          `json:"null_rating_reason,omitempty"`
-	EpisodesInfo     string          `json:"episodes_info,omitempty"`
-	HonorInfos       json.RawMessage `json:"honor_infos,omitempty"`
+	ID               string           `json:"id"`
+	Type             string           `json:"type"`
+	Title            string           `json:"title"`
+	Year             string           `json:"year,omitempty"`
+	URI              string           `json:"uri,omitempty"`
+	URL              string           `json:"url,omitempty"`
+	CardSubtitle     string           `json:"card_subtitle,omitempty"`
+	Rating           *HotMediaRating  `json:"rating,omitempty"`
+	Pic              *HotMediaPic     `json:"pic,omitempty"`
+	Playable         *bool            `json:"playable,omitempty"`
+	NullRatingReason string           `json:"null_rating_reason,omitempty"`
+	EpisodesInfo     string           `json:"episodes_info,omitempty"`
+	HonorInfos       json.RawMessage  `json:"honor_infos,omitempty"`
+	Detail           *movie.MovieInfo `json:"detail,omitempty"`
 }
 
 type HotMediaRating struct {
@@ -51,3 +57,90 @@ type HotMediaPic struct {
 	Large  string `json:"large"`
 	Normal string `json:"normal"`
 }
+
+type Top250Response struct {
+	Total int          `json:"total"`
+	Items []Top250Item `json:"items"`
+}
+
+type Top250Item struct {
+	Rank          int      `json:"rank"`
+	ID            string   `json:"id"`
+	Title         string   `json:"title"`
+	OriginalTitle string   `json:"original_title,omitempty"`
+	OtherTitles   []string `json:"other_titles,omitempty"`
+	Year          string   `json:"year,omitempty"`
+	Rating        float64  `json:"rating"`
+	Votes         int      `json:"votes"`
+	Quote         string   `json:"quote,omitempty"`
+	Pic           string   `json:"pic,omitempty"`
+	URL           string   `json:"url,omitempty"`
+
+	Detail *movie.MovieInfo `json:"detail,omitempty"`
+}
+
+type searchSubjectsResponse struct {
+	Subjects []searchSubject `json:"subjects"`
+}
+
+type searchSubject struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	Rate         string `json:"rate"`
+	URL          string `json:"url"`
+	Cover        string `json:"cover"`
+	Playable     bool   `json:"playable"`
+	IsNew        bool   `json:"is_new"`
+	EpisodesInfo string `json:"episodes_info"`
+}
+
+type Catalog struct {
+	Subject    string            `json:"subject"`
+	Categories []CatalogCategory `json:"categories"`
+}
+
+type CatalogCategory struct {
+	Category string           `json:"category"`
+	Title    string           `json:"title"`
+	Types    []HotMediaOption `json:"types"`
+}
+
+type Snapshot struct {
+	ID      string         `json:"id"`
+	List    string         `json:"list"`
+	TakenAt time.Time      `json:"taken_at"`
+	Total   int            `json:"total"`
+	Items   []HotMediaItem `json:"items"`
+}
+
+type SnapshotDiff struct {
+	List          string               `json:"list"`
+	From          string               `json:"from"`
+	To            string               `json:"to"`
+	Entered       []SnapshotDiffItem   `json:"entered"`
+	Dropped       []SnapshotDiffItem   `json:"dropped"`
+	Moved         []SnapshotRankMove   `json:"moved"`
+	RatingChanged []SnapshotRatingMove `json:"rating_changed"`
+}
+
+type SnapshotDiffItem struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Rank  int    `json:"rank"`
+}
+
+type SnapshotRankMove struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	From  int    `json:"from"`
+	To    int    `json:"to"`
+	Delta int    `json:"delta"`
+}
+
+type SnapshotRatingMove struct {
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Delta float64 `json:"delta"`
+}
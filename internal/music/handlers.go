@@ -0,0 +1,23 @@
+package music
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type Handlers struct {
+	service *Service
+}
+
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+func (h *Handlers) Search(c *gin.Context) {
+	subject.Search(c, h.service.Search)
+}
+
+func (h *Handlers) ByID(c *gin.Context) {
+	subject.ByID(c, h.service.GetMusicInfo)
+}
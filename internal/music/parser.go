@@ -0,0 +1,185 @@
+package music
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type parser struct {
+	reInfoPair         *regexp.Regexp
+	reRemoveSplitSpace *regexp.Regexp
+	reTrackNumber      *regexp.Regexp
+	reCoverSize        *regexp.Regexp
+	reStripTags        *regexp.Regexp
+}
+
+func newParser() *parser {
+	return &parser{
+		reInfoPair:         regexp.MustCompile(`([^\s]+?):\s*([^\n]+)`),
+		reRemoveSplitSpace: regexp.MustCompile(`\s+?/\s+`),
+		reTrackNumber:      regexp.MustCompile(`^(?:[0-9]+[.、)]|[0-9]+\s+-)\s*`),
+		reCoverSize:        regexp.MustCompile(`/view/subject/[sml]/public/`),
+		reStripTags:        regexp.MustCompile(`(?s)<[^>]*>`),
+	}
+}
+
+func (p *parser) parseSearchList(doc *goquery.Document, count int) []DoubanMusic {
+	return subject.ParseSearchList(doc, count, func(r subject.SearchResult) DoubanMusic {
+		artists, pubdate := p.parseSubjectCast(r.Cast)
+		return DoubanMusic{
+			ID:        r.ID,
+			Title:     r.Title,
+			AltTitle:  "",
+			Artists:   artists,
+			Genre:     "",
+			Version:   "",
+			Media:     "",
+			Pubdate:   pubdate,
+			Publisher: "",
+			Discs:     "",
+			Barcode:   "",
+			ISRC:      "",
+			Rating:    Rating{Average: r.Rating},
+			Images:    p.coverImages(r.Cover),
+			Tracks:    []Track{},
+			Summary:   r.Summary,
+			Tags:      []Tag{},
+		}
+	})
+}
+
+func (p *parser) parseMusicPage(doc *goquery.Document, id string) DoubanMusic {
+	wrapper := doc.Find("#wrapper")
+	content := wrapper.Find("#content")
+	title := strings.TrimSpace(wrapper.Find("h1>span").First().Text())
+	cover, _ := content.Find("#mainpic img").Attr("src")
+
+	tags := make([]Tag, 0)
+	content.Find("div.tags-body a").Each(func(_ int, t *goquery.Selection) {
+		tags = append(tags, Tag{Name: strings.TrimSpace(t.Text())})
+	})
+
+	rating := Rating{Average: subject.ParseRating(content.Find("strong.rating_num").Text())}
+
+	summary := strings.TrimSpace(content.Find("#link-report span.all").Text())
+	if summary == "" {
+		summary = strings.TrimSpace(content.Find("#link-report span").First().Text())
+	}
+
+	infoMap := p.parseInfoText(p.infoText(content.Find("#info")))
+
+	return DoubanMusic{
+		ID:        id,
+		Title:     title,
+		AltTitle:  p.getText(infoMap, "又名"),
+		Artists:   subject.SplitValues(infoMap["表演者"]),
+		Genre:     p.getText(infoMap, "流派"),
+		Version:   p.getText(infoMap, "专辑类型"),
+		Media:     p.getText(infoMap, "介质"),
+		Pubdate:   p.getText(infoMap, "发行时间"),
+		Publisher: p.getText(infoMap, "出版者"),
+		Discs:     p.getText(infoMap, "唱片数"),
+		Barcode:   p.getText(infoMap, "条形码"),
+		ISRC:      p.getText(infoMap, "ISRC(中国)"),
+		Rating:    rating,
+		Images:    p.coverImages(cover),
+		Tracks:    p.parseTracks(content),
+		Summary:   summary,
+		Tags:      tags,
+	}
+}
+
+func (p *parser) parseTracks(content *goquery.Selection) []Track {
+	tracks := make([]Track, 0)
+	list := content.Find("ul.track-listing li")
+	if list.Length() > 0 {
+		list.Each(func(_ int, li *goquery.Selection) {
+			p.appendTrack(&tracks, li.Text())
+		})
+		return tracks
+	}
+
+	text := content.Find("div.track-list div.indent").First().Text()
+	for _, line := range strings.Split(text, "\n") {
+		p.appendTrack(&tracks, line)
+	}
+	return tracks
+}
+
+func (p *parser) appendTrack(tracks *[]Track, line string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.Contains(line, "(展开)") || strings.Contains(line, "(收起)") {
+		return
+	}
+	title := strings.TrimSpace(p.reTrackNumber.ReplaceAllString(line, ""))
+	if title == "" {
+		return
+	}
+	*tracks = append(*tracks, Track{Index: len(*tracks) + 1, Title: title})
+}
+
+func (p *parser) coverImages(cover string) Image {
+	cover = strings.TrimSpace(cover)
+	if !p.reCoverSize.MatchString(cover) {
+		return Image{Small: cover, Medium: cover, Large: cover}
+	}
+	return Image{
+		Small:  p.reCoverSize.ReplaceAllString(cover, "/view/subject/s/public/"),
+		Medium: p.reCoverSize.ReplaceAllString(cover, "/view/subject/m/public/"),
+		Large:  p.reCoverSize.ReplaceAllString(cover, "/view/subject/l/public/"),
+	}
+}
+
+func (p *parser) parseSubjectCast(text string) ([]string, string) {
+	artists := make([]string, 0)
+	pubdate := ""
+	for _, part := range strings.Split(text, "/") {
+		v := strings.TrimSpace(part)
+		if v == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(v); err == nil && len(v) == 4 {
+			pubdate = v
+			continue
+		}
+		if pubdate == "" {
+			artists = append(artists, v)
+		}
+	}
+	return artists, pubdate
+}
+
+func (p *parser) infoText(info *goquery.Selection) string {
+	rawHTML, err := info.Html()
+	if err != nil {
+		return strings.TrimSpace(info.Text())
+	}
+	text := strings.NewReplacer("<br/>", "\n", "<br />", "\n", "<br>", "\n").Replace(rawHTML)
+	text = html.UnescapeString(p.reStripTags.ReplaceAllString(text, ""))
+	return strings.TrimSpace(strings.ReplaceAll(text, "\u00a0", " "))
+}
+
+func (p *parser) parseInfoText(s string) map[string]string {
+	m := make(map[string]string)
+	fixed := p.reRemoveSplitSpace.ReplaceAllString(s, "/")
+	matches := p.reInfoPair.FindAllStringSubmatch(fixed, -1)
+	for _, cap := range matches {
+		if len(cap) >= 3 {
+			m[strings.TrimSpace(cap[1])] = strings.TrimSpace(cap[2])
+		}
+	}
+	return m
+}
+
+func (p *parser) getText(info map[string]string, key string) string {
+	if v, ok := info[key]; ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+package music
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func loadDocument(t *testing.T, name string) *goquery.Document {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestParseSearchList(t *testing.T) {
+	p := newParser()
+	got := p.parseSearchList(loadDocument(t, "search.html"), 0)
+	want := []DoubanMusic{
+		{
+			ID:      "1394539",
+			Title:   "范特西",
+			Artists: []string{"周杰伦"},
+			Pubdate: "2001",
+			Rating:  Rating{Average: 9.3},
+			Images: Image{
+				Small:  "https://img2.doubanio.com/view/subject/s/public/s1416697.jpg",
+				Medium: "https://img2.doubanio.com/view/subject/m/public/s1416697.jpg",
+				Large:  "https://img2.doubanio.com/view/subject/l/public/s1416697.jpg",
+			},
+			Tracks:  []Track{},
+			Summary: "周杰伦第二张专辑。",
+			Tags:    []Tag{},
+		},
+		{
+			ID:      "3040149",
+			Title:   "范特西 Plus",
+			Artists: []string{"周杰伦", "费玉清"},
+			Pubdate: "2001",
+			Images: Image{
+				Small:  "https://img1.doubanio.com/f/shire/placeholder.png",
+				Medium: "https://img1.doubanio.com/f/shire/placeholder.png",
+				Large:  "https://img1.doubanio.com/f/shire/placeholder.png",
+			},
+			Tracks: []Track{},
+			Tags:   []Tag{},
+		},
+		{
+			ID:      "1111111",
+			Title:   "范特西 (Live)",
+			Artists: []string{},
+			Tracks:  []Track{},
+			Tags:    []Tag{},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSearchList =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := p.parseSearchList(loadDocument(t, "search.html"), 2); len(got) != 2 {
+		t.Errorf("parseSearchList(count=2) returned %d items", len(got))
+	}
+}
+
+func TestParseMusicPage(t *testing.T) {
+	got := newParser().parseMusicPage(loadDocument(t, "subject.html"), "1394539")
+	want := DoubanMusic{
+		ID:        "1394539",
+		Title:     "范特西",
+		AltTitle:  "Fantasy",
+		Artists:   []string{"周杰伦", "Jay Chou"},
+		Genre:     "流行",
+		Version:   "专辑",
+		Media:     "CD",
+		Pubdate:   "2001-09-14",
+		Publisher: "阿尔发音乐",
+		Discs:     "1",
+		Barcode:   "4710474202313",
+		ISRC:      "CN-E15-01-0045-0/A.J6",
+		Rating:    Rating{Average: 9.3},
+		Images: Image{
+			Small:  "https://img2.doubanio.com/view/subject/s/public/s1416697.jpg",
+			Medium: "https://img2.doubanio.com/view/subject/m/public/s1416697.jpg",
+			Large:  "https://img2.doubanio.com/view/subject/l/public/s1416697.jpg",
+		},
+		Tracks: []Track{
+			{Index: 1, Title: "爱在西元前"},
+			{Index: 2, Title: "爸我回来了"},
+			{Index: 3, Title: "简单爱"},
+			{Index: 4, Title: "忍者"},
+		},
+		Summary: "范特西是周杰伦的第二张录音室专辑 & 代表作。",
+		Tags:    []Tag{{Name: "周杰伦"}, {Name: "华语"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseMusicPage =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseTrackListing(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div id="content"><ul class="track-listing"><li>1. Intro</li><li> </li><li>2) Outro</li></ul></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := newParser().parseTracks(doc.Find("#content"))
+	want := []Track{{Index: 1, Title: "Intro"}, {Index: 2, Title: "Outro"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTracks = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,39 @@
+package music
+
+import (
+	"context"
+
+	"github.com/haigeek/douban-api-go/internal/httpclient"
+	"github.com/haigeek/douban-api-go/internal/subject"
+)
+
+type Service struct {
+	subjects *subject.Service[DoubanMusic]
+	parser   *parser
+}
+
+func NewService(client *httpclient.Client) *Service {
+	return &Service{
+		subjects: subject.NewService[DoubanMusic](client, "1003", "https://music.douban.com/subject/%s/"),
+		parser:   newParser(),
+	}
+}
+
+func (s *Service) Search(ctx context.Context, q string, count int) (DoubanMusicResult, error) {
+	if q == "" {
+		return DoubanMusicResult{Musics: []DoubanMusic{}}, nil
+	}
+	doc, err := s.subjects.Search(ctx, q)
+	if err != nil {
+		return DoubanMusicResult{}, err
+	}
+	return DoubanMusicResult{
+		Code:   0,
+		Msg:    "",
+		Musics: s.parser.parseSearchList(doc, count),
+	}, nil
+}
+
+func (s *Service) GetMusicInfo(ctx context.Context, id string) (DoubanMusic, error) {
+	return s.subjects.Get(ctx, id, s.parser.parseMusicPage)
+}
@@ -0,0 +1,46 @@
+package music
+
+type DoubanMusicResult struct {
+	Code   uint32        `json:"code"`
+	Msg    string        `json:"msg"`
+	Musics []DoubanMusic `json:"musics"`
+}
+
+type DoubanMusic struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	AltTitle  string   `json:"alt_title"`
+	Artists   []string `json:"artists"`
+	Genre     string   `json:"genre"`
+	Version   string   `json:"version"`
+	Media     string   `json:"media"`
+	Pubdate   string   `json:"pubdate"`
+	Publisher string   `json:"publisher"`
+	Discs     string   `json:"discs"`
+	Barcode   string   `json:"barcode"`
+	ISRC      string   `json:"isrc"`
+	Rating    Rating   `json:"rating"`
+	Images    Image    `json:"images"`
+	Tracks    []Track  `json:"tracks"`
+	Summary   string   `json:"summary"`
+	Tags      []Tag    `json:"tags"`
+}
+
+type Track struct {
+	Index int    `json:"index"`
+	Title string `json:"title"`
+}
+
+type Image struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type Rating struct {
+	Average float32 `json:"average"`
+}
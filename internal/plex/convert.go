@@ -0,0 +1,137 @@
+package plex
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+const (
+	Identifier = "tv.plex.agents.custom.douban"
+
+	typeMovie = 1
+	catMovie  = "电影"
+)
+
+func guidFor(sid string) string {
+	return Identifier + "://movie/" + sid
+}
+
+func parseGUID(guid string) (string, bool) {
+	sid, ok := strings.CutPrefix(guid, Identifier+"://movie/")
+	if !ok || sid == "" {
+		return "", false
+	}
+	return sid, true
+}
+
+func isMovie(info movie.MovieInfo) bool {
+	return info.Episodes == ""
+}
+
+func toMatchMetadata(c movie.MatchCandidate, base string) Metadata {
+	year, _ := strconv.Atoi(c.Year)
+	return Metadata{
+		RatingKey:     c.SID,
+		Key:           "/library/metadata/" + c.SID,
+		GUID:          guidFor(c.SID),
+		Type:          "movie",
+		Title:         c.Name,
+		OriginalTitle: c.OriginalName,
+		Year:          year,
+		Thumb:         proxyURL(base, c.Img),
+		Score:         int(c.Score*100 + 0.5),
+	}
+}
+
+func toMetadata(info movie.MovieInfo, celebrities []movie.Celebrity, photos []movie.Photo, base string) Metadata {
+	year, _ := strconv.Atoi(info.Year)
+	minutes := movie.FirstNumber(info.Duration)
+	originalTitle := info.OriginalName
+	if originalTitle == info.Name {
+		originalTitle = ""
+	}
+
+	meta := Metadata{
+		RatingKey:             info.SID,
+		Key:                   "/library/metadata/" + info.SID,
+		GUID:                  guidFor(info.SID),
+		Type:                  "movie",
+		Title:                 info.Name,
+		OriginalTitle:         originalTitle,
+		Summary:               info.Intro,
+		Year:                  year,
+		OriginallyAvailableAt: movie.FirstDate(info.Screen),
+		Duration:              minutes * 60 * 1000,
+		Thumb:                 proxyURL(base, info.Img),
+		Genre:                 toTags(info.Genre),
+		Country:               toTags(info.Country),
+		Director:              []Person{},
+		Writer:                []Person{},
+		Role:                  []Person{},
+		Rating:                []Rating{},
+		Image:                 []Image{},
+		Guid:                  []GUID{},
+	}
+
+	if rating, err := strconv.ParseFloat(info.Rating, 64); err == nil && rating > 0 {
+		meta.Rating = append(meta.Rating, Rating{Image: "douban://image.rating", Type: "audience", Value: rating})
+	}
+	if info.IMDB != "" {
+		meta.Guid = append(meta.Guid, GUID{ID: "imdb://" + info.IMDB})
+	}
+	if info.Img != "" {
+		meta.Image = append(meta.Image, Image{Type: "coverPoster", URL: proxyURL(base, info.Img), Alt: info.Name})
+	}
+	for i, photo := range photos {
+		art := proxyURL(base, photo.Large)
+		if i == 0 {
+			meta.Art = art
+		}
+		meta.Image = append(meta.Image, Image{Type: "background", URL: art, Alt: info.Name})
+	}
+
+	for _, c := range celebrities {
+		switch c.RoleType {
+		case "导演":
+			meta.Director = append(meta.Director, Person{Tag: c.Name, Thumb: proxyURL(base, c.Img)})
+		case "演员", "配音":
+			role := c.Role
+			if role == c.RoleType {
+				role = ""
+			}
+			meta.Role = append(meta.Role, Person{Tag: c.Name, Role: role, Thumb: proxyURL(base, c.Img), Order: len(meta.Role) + 1})
+		}
+	}
+	if len(meta.Director) == 0 {
+		for _, name := range movie.SplitList(info.Director) {
+			meta.Director = append(meta.Director, Person{Tag: name})
+		}
+	}
+	if len(meta.Role) == 0 {
+		for _, name := range movie.SplitList(info.Actor) {
+			meta.Role = append(meta.Role, Person{Tag: name, Order: len(meta.Role) + 1})
+		}
+	}
+	for _, name := range movie.SplitList(info.Writer) {
+		meta.Writer = append(meta.Writer, Person{Tag: name})
+	}
+	return meta
+}
+
+func proxyURL(base, rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	return base + "/proxy?url=" + url.QueryEscape(rawURL)
+}
+
+func toTags(raw string) []Tag {
+	tags := make([]Tag, 0)
+	for _, name := range movie.SplitList(raw) {
+		tags = append(tags, Tag{Tag: name})
+	}
+	return tags
+}
@@ -0,0 +1,116 @@
+package plex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+const testBase = "http://nas:5000"
+
+func TestParseGUID(t *testing.T) {
+	tests := []struct {
+		guid string
+		sid  string
+		ok   bool
+	}{
+		{"tv.plex.agents.custom.douban://movie/1292052", "1292052", true},
+		{guidFor("26862259"), "26862259", true},
+		{"tv.plex.agents.custom.douban://movie/", "", false},
+		{"tv.plex.agents.custom.douban://show/1292052", "", false},
+		{"com.plexapp.agents.imdb://tt0111161", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		sid, ok := parseGUID(tt.guid)
+		if sid != tt.sid || ok != tt.ok {
+			t.Errorf("parseGUID(%q) = %q, %v, want %q, %v", tt.guid, sid, ok, tt.sid, tt.ok)
+		}
+	}
+}
+
+func TestToMetadata(t *testing.T) {
+	info := movie.MovieInfo{
+		SID:          "1292052",
+		Name:         "肖申克的救赎",
+		OriginalName: "The Shawshank Redemption",
+		Rating:       "9.7",
+		Img:          "https://img2.doubanio.com/view/photo/s_ratio_poster/public/p480747492.jpg",
+		Year:         "1994",
+		Intro:        "希望让人自由。",
+		Director:     "弗兰克·德拉邦特",
+		Writer:       "弗兰克·德拉邦特 / 斯蒂芬·金",
+		Actor:        "蒂姆·罗宾斯 / 摩根·弗里曼",
+		Genre:        "剧情 / 犯罪",
+		Country:      "美国",
+		Screen:       "1994-09-10(多伦多电影节) / 1994-10-14(美国)",
+		Duration:     "142分钟",
+		IMDB:         "tt0111161",
+	}
+	celebrities := []movie.Celebrity{
+		{Name: "弗兰克·德拉邦特", RoleType: "导演", Role: "导演", Img: "https://img2.doubanio.com/view/celebrity/raw/public/p230.jpg"},
+		{Name: "蒂姆·罗宾斯", RoleType: "演员", Role: "饰 安迪"},
+		{Name: "摩根·弗里曼", RoleType: "演员", Role: "演员"},
+		{Name: "制片人", RoleType: "制片人"},
+	}
+	photos := []movie.Photo{
+		{Large: "https://img2.doubanio.com/view/photo/l/public/p1.jpg"},
+		{Large: "https://img2.doubanio.com/view/photo/l/public/p2.jpg"},
+	}
+	poster := testBase + "/proxy?url=https%3A%2F%2Fimg2.doubanio.com%2Fview%2Fphoto%2Fs_ratio_poster%2Fpublic%2Fp480747492.jpg"
+	art1 := testBase + "/proxy?url=https%3A%2F%2Fimg2.doubanio.com%2Fview%2Fphoto%2Fl%2Fpublic%2Fp1.jpg"
+	art2 := testBase + "/proxy?url=https%3A%2F%2Fimg2.doubanio.com%2Fview%2Fphoto%2Fl%2Fpublic%2Fp2.jpg"
+	want := Metadata{
+		RatingKey:             "1292052",
+		Key:                   "/library/metadata/1292052",
+		GUID:                  "tv.plex.agents.custom.douban://movie/1292052",
+		Type:                  "movie",
+		Title:                 "肖申克的救赎",
+		OriginalTitle:         "The Shawshank Redemption",
+		Summary:               "希望让人自由。",
+		Year:                  1994,
+		OriginallyAvailableAt: "1994-09-10",
+		Duration:              142 * 60 * 1000,
+		Thumb:                 poster,
+		Art:                   art1,
+		Genre:                 []Tag{{Tag: "剧情"}, {Tag: "犯罪"}},
+		Country:               []Tag{{Tag: "美国"}},
+		Director:              []Person{{Tag: "弗兰克·德拉邦特", Thumb: testBase + "/proxy?url=https%3A%2F%2Fimg2.doubanio.com%2Fview%2Fcelebrity%2Fraw%2Fpublic%2Fp230.jpg"}},
+		Writer:                []Person{{Tag: "弗兰克·德拉邦特"}, {Tag: "斯蒂芬·金"}},
+		Role:                  []Person{{Tag: "蒂姆·罗宾斯", Role: "饰 安迪", Order: 1}, {Tag: "摩根·弗里曼", Order: 2}},
+		Rating:                []Rating{{Image: "douban://image.rating", Type: "audience", Value: 9.7}},
+		Image: []Image{
+			{Type: "coverPoster", URL: poster, Alt: "肖申克的救赎"},
+			{Type: "background", URL: art1, Alt: "肖申克的救赎"},
+			{Type: "background", URL: art2, Alt: "肖申克的救赎"},
+		},
+		Guid: []GUID{{ID: "imdb://tt0111161"}},
+	}
+	if got := toMetadata(info, celebrities, photos, testBase); !reflect.DeepEqual(got, want) {
+		t.Errorf("toMetadata =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestToMetadataFallbacks(t *testing.T) {
+	info := movie.MovieInfo{
+		SID:          "42",
+		Name:         "无名",
+		OriginalName: "无名",
+		Rating:       "暂无",
+		Director:     "甲 / 乙",
+		Actor:        "丙",
+	}
+	got := toMetadata(info, nil, nil, testBase)
+	if got.OriginalTitle != "" || got.Thumb != "" || got.Art != "" {
+		t.Errorf("unexpected title/images: %+v", got)
+	}
+	if len(got.Rating) != 0 || len(got.Image) != 0 || len(got.Guid) != 0 {
+		t.Errorf("unexpected ratings/images/guids: %+v", got)
+	}
+	wantDirectors := []Person{{Tag: "甲"}, {Tag: "乙"}}
+	wantRoles := []Person{{Tag: "丙", Order: 1}}
+	if !reflect.DeepEqual(got.Director, wantDirectors) || !reflect.DeepEqual(got.Role, wantRoles) {
+		t.Errorf("directors = %+v, roles = %+v", got.Director, got.Role)
+	}
+}
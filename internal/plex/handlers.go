@@ -0,0 +1,143 @@
+package plex
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+type Handlers struct {
+	movie *movie.Service
+}
+
+func NewHandlers(movieService *movie.Service) *Handlers {
+	return &Handlers{movie: movieService}
+}
+
+func (h *Handlers) Provider(c *gin.Context) {
+	c.JSON(http.StatusOK, ProviderResponse{MediaProvider: MediaProvider{
+		Identifier: Identifier,
+		Title:      "Douban",
+		Version:    "1.0.0",
+		Types: []Type{
+			{Type: typeMovie, Scheme: []Scheme{{Scheme: Identifier}}},
+		},
+		Feature: []Feature{
+			{Type: "metadata", Key: "/library/metadata"},
+			{Type: "match", Key: "/library/metadata/matches"},
+		},
+	}})
+}
+
+func (h *Handlers) Match(c *gin.Context) {
+	var req MatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid request body"})
+		return
+	}
+
+	if sid, ok := parseGUID(req.GUID); ok {
+		info, err := h.movie.GetMovieInfo(c.Request.Context(), sid, "l")
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+		metadata := make([]Metadata, 0, 1)
+		if isMovie(info) {
+			metadata = append(metadata, toMetadata(info, info.Celebrities, nil, baseURL(c)))
+		}
+		h.writeContainer(c, metadata)
+		return
+	}
+
+	name := strings.TrimSpace(path.Base(strings.ReplaceAll(req.Filename, `\`, "/")))
+	if req.Filename == "" {
+		name = strings.TrimSpace(req.Title)
+	}
+	if name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "title or filename is required"})
+		return
+	}
+	parsed := movie.ParseFileName(name)
+	if req.Year > 0 {
+		parsed.Year = strconv.Itoa(req.Year)
+	}
+
+	result, err := h.movie.MatchParsed(c.Request.Context(), parsed, "l")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	candidates := make([]movie.MatchCandidate, 0, len(result.Alternatives)+1)
+	if result.Best != nil {
+		candidates = append(candidates, *result.Best)
+	}
+	candidates = append(candidates, result.Alternatives...)
+
+	metadata := make([]Metadata, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Cat != catMovie {
+			continue
+		}
+		metadata = append(metadata, toMatchMetadata(candidate, baseURL(c)))
+	}
+	if req.Manual == 0 && len(metadata) > 1 {
+		metadata = metadata[:1]
+	}
+	h.writeContainer(c, metadata)
+}
+
+func (h *Handlers) Metadata(c *gin.Context) {
+	sid := c.Param("ratingKey")
+	if _, err := strconv.Atoi(sid); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"message": "metadata not found"})
+		return
+	}
+
+	info, err := h.movie.GetMovieInfo(c.Request.Context(), sid, "l")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	if info.Name == "" || !isMovie(info) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "metadata not found"})
+		return
+	}
+
+	celebrities, err := h.movie.GetCelebrities(c.Request.Context(), sid)
+	if err != nil || len(celebrities) == 0 {
+		celebrities = info.Celebrities
+	}
+	photos, err := h.movie.GetWallpaper(c.Request.Context(), sid)
+	if err != nil {
+		photos = nil
+	}
+	h.writeContainer(c, []Metadata{toMetadata(info, celebrities, photos, baseURL(c))})
+}
+
+func (h *Handlers) writeContainer(c *gin.Context, metadata []Metadata) {
+	c.JSON(http.StatusOK, MediaContainerResponse{MediaContainer: MediaContainer{
+		Offset:     0,
+		TotalSize:  len(metadata),
+		Identifier: Identifier,
+		Size:       len(metadata),
+		Metadata:   metadata,
+	}})
+}
+
+func baseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
@@ -0,0 +1,99 @@
+package plex
+
+type ProviderResponse struct {
+	MediaProvider MediaProvider `json:"MediaProvider"`
+}
+
+type MediaProvider struct {
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	Version    string    `json:"version"`
+	Types      []Type    `json:"Types"`
+	Feature    []Feature `json:"Feature"`
+}
+
+type Type struct {
+	Type   int      `json:"type"`
+	Scheme []Scheme `json:"Scheme"`
+}
+
+type Scheme struct {
+	Scheme string `json:"scheme"`
+}
+
+type Feature struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+}
+
+type MatchRequest struct {
+	Type     int    `json:"type"`
+	Title    string `json:"title"`
+	Year     int    `json:"year"`
+	GUID     string `json:"guid"`
+	Filename string `json:"filename"`
+	Manual   int    `json:"manual"`
+}
+
+type MediaContainerResponse struct {
+	MediaContainer MediaContainer `json:"MediaContainer"`
+}
+
+type MediaContainer struct {
+	Offset     int        `json:"offset"`
+	TotalSize  int        `json:"totalSize"`
+	Identifier string     `json:"identifier"`
+	Size       int        `json:"size"`
+	Metadata   []Metadata `json:"Metadata"`
+}
+
+type Metadata struct {
+	RatingKey             string   `json:"ratingKey"`
+	Key                   string   `json:"key"`
+	GUID                  string   `json:"guid"`
+	Type                  string   `json:"type"`
+	Title                 string   `json:"title"`
+	OriginalTitle         string   `json:"originalTitle,omitempty"`
+	Summary               string   `json:"summary,omitempty"`
+	Year                  int      `json:"year,omitempty"`
+	OriginallyAvailableAt string   `json:"originallyAvailableAt,omitempty"`
+	Duration              int      `json:"duration,omitempty"`
+	Thumb                 string   `json:"thumb,omitempty"`
+	Art                   string   `json:"art,omitempty"`
+	Score                 int      `json:"score,omitempty"`
+	Genre                 []Tag    `json:"Genre,omitempty"`
+	Country               []Tag    `json:"Country,omitempty"`
+	Director              []Person `json:"Director,omitempty"`
+	Writer                []Person `json:"Writer,omitempty"`
+	Role                  []Person `json:"Role,omitempty"`
+	Rating                []Rating `json:"Rating,omitempty"`
+	Image                 []Image  `json:"Image,omitempty"`
+	Guid                  []GUID   `json:"Guid,omitempty"`
+}
+
+type Tag struct {
+	Tag string `json:"tag"`
+}
+
+type Person struct {
+	Tag   string `json:"tag"`
+	Role  string `json:"role,omitempty"`
+	Thumb string `json:"thumb,omitempty"`
+	Order int    `json:"order,omitempty"`
+}
+
+type Rating struct {
+	Image string  `json:"image"`
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+}
+
+type Image struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	Alt  string `json:"alt,omitempty"`
+}
+
+type GUID struct {
+	ID string `json:"id"`
+}
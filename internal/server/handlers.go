@@ -1,8 +1,11 @@
 package server
 
 import (
+	"errors"
 	"net/http"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,6 +13,8 @@ import (
 	"github.com/haigeek/douban-api-go/internal/config"
 )
 
+var reIMDBID = regexp.MustCompile(`^tt[0-9]{7,}$`)
+
 type Handlers struct {
 	movie *movie.Service
 	cfg   config.Config
@@ -22,19 +27,58 @@ func NewHandlers(movieService *movie.Service, cfg config.Config) *Handlers {
 func (h *Handlers) Index(c *gin.Context) {
 	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(`
        接口列表：<br/>
+       /openapi.json<br/>
+       /docs<br/>
        /movies?q={movie_name}<br/>
        /movies?q={movie_name}&type=full<br/>
        /movies/{sid}<br/>
+       /movies/{sid}.nfo?type=movie<br/>
+       /movies/imdb/{imdb_id}<br/>
+       /match?q={file_name}<br/>
        /movies/{sid}/celebrities<br/>
        /celebrities/{cid}<br/>
        /photo/{sid}<br/>
-       /v2/book/search?q={book_name}<br/>
+       /v2/book/search?q={book_name}&start=0&count=2<br/>
+       /v2/book/search?q={book_name}&type=full<br/>
        /v2/book/id/{sid}<br/>
+       /v2/book/id/{sid}.opf?version=2<br/>
+       /v2/book/id/{sid}/editions<br/>
+       /v2/book/id/{sid}/comments?start=0&sort=hot<br/>
+       /v2/book/id/{sid}/reviews?start=0&sort=hot<br/>
+       /v2/book/id/{sid}/annotations?start=0&sort=hot<br/>
        /v2/book/isbn/{isbn}<br/>
+       /v2/book/isbn/{isbn}.opf?version=2<br/>
+       /v2/book/series/{id}<br/>
+       /v2/book/author/{id}?start=0<br/>
+       POST /v2/book/batch<br/>
+       /v2/music/search?q={music_name}<br/>
+       /v2/music/id/{sid}<br/>
+       /v2/game/search?q={game_name}<br/>
+       /v2/game/id/{sid}<br/>
+       /v2/drama/search?q={drama_name}<br/>
+       /v2/drama/id/{sid}<br/>
        /v2/media/hot/tv?start=0&limit=20<br/>
        /v2/media/hot/movie?start=0&limit=20<br/>
        /v2/media/latest/movie?start=0&limit=20<br/>
        /v2/media/high-rating/movie?start=0&limit=20<br/>
+       /v2/media/top250?start=0&limit=20<br/>
+       /v2/media/browse?type=movie&tag={tag}&sort=recommend&start=0&limit=20<br/>
+       /v2/media/recent_hot/{subject}?category={category}&type={type}&start=0&limit=20<br/>
+       /v2/media/categories<br/>
+       /v2/media/snapshots<br/>
+       /v2/media/snapshots/{list}<br/>
+       /v2/media/snapshots/{list}/{id}<br/>
+       /v2/media/snapshots/{list}/diff?from={id}&to={id}<br/>
+       /v2/suggest?q={keyword}&type=movie<br/>
+       /v2/suggest?q={keyword}&type=book<br/>
+       GET|POST /graphql<br/>
+       /plex<br/>
+       POST /plex/library/metadata/matches<br/>
+       /plex/library/metadata/{sid}<br/>
+       /tmdb/3/search/movie?query={movie_name} (--tmdb)<br/>
+       /tmdb/3/search/tv?query={tv_name} (--tmdb)<br/>
+       /tmdb/3/movie/{sid}?append_to_response=credits,images (--tmdb)<br/>
+       /tmdb/3/tv/{sid}?append_to_response=credits,images (--tmdb)<br/>
     `))
 }
 
@@ -80,14 +124,60 @@ func (h *Handlers) Movies(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+func (h *Handlers) Match(c *gin.Context) {
+	q := c.Query("q")
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "q is required"})
+		return
+	}
+
+	imageSize := c.DefaultQuery("s", "")
+	result, err := h.movie.Match(c.Request.Context(), q, imageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
 func (h *Handlers) Movie(c *gin.Context) {
-	sid := c.Param("sid")
+	sid, asNFO := strings.CutSuffix(c.Param("sid"), movie.NFOSuffix)
+	kind := c.DefaultQuery("type", "")
+	if asNFO && kind != "" && kind != movie.NFOMovie && kind != movie.NFOTVShow {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid type"})
+		return
+	}
 	imageSize := c.DefaultQuery("s", "")
 	result, err := h.movie.GetMovieInfo(c.Request.Context(), sid, imageSize)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
+	if asNFO {
+		body := h.movie.NFO(c.Request.Context(), result, kind)
+		c.Data(http.StatusOK, "application/xml; charset=utf-8", body)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handlers) MovieByIMDB(c *gin.Context) {
+	imdbID := c.Param("tt")
+	if !reIMDBID.MatchString(imdbID) {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid imdb id"})
+		return
+	}
+
+	imageSize := c.DefaultQuery("s", "")
+	result, err := h.movie.GetMovieInfoByIMDB(c.Request.Context(), imdbID, imageSize)
+	if err != nil {
+		if errors.Is(err, movie.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"message": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
 	c.JSON(http.StatusOK, result)
 }
 
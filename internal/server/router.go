@@ -4,10 +4,17 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/haigeek/douban-api-go/internal/book"
+	"github.com/haigeek/douban-api-go/internal/drama"
+	"github.com/haigeek/douban-api-go/internal/game"
+	"github.com/haigeek/douban-api-go/internal/graphql"
 	"github.com/haigeek/douban-api-go/internal/media"
+	"github.com/haigeek/douban-api-go/internal/music"
+	"github.com/haigeek/douban-api-go/internal/plex"
+	"github.com/haigeek/douban-api-go/internal/suggest"
+	"github.com/haigeek/douban-api-go/internal/tmdb"
 )
 
-func NewRouter(h *Handlers, b *book.Handlers, m *media.Handlers, debug bool) *gin.Engine {
+func NewRouter(h *Handlers, b *book.Handlers, m *media.Handlers, sg *suggest.Handlers, mu *music.Handlers, gm *game.Handlers, dr *drama.Handlers, tm *tmdb.Handlers, px *plex.Handlers, gq *graphql.Handlers, debug bool) *gin.Engine {
 	if !debug {
 		gin.SetMode(gin.ReleaseMode)
 	}
@@ -21,8 +28,12 @@ func NewRouter(h *Handlers, b *book.Handlers, m *media.Handlers, debug bool) *gi
 	}
 
 	r.GET("/", h.Index)
+	r.GET("/openapi.json", h.OpenAPI)
+	r.GET("/docs", h.SwaggerUI)
 	r.GET("/movies", h.Movies)
 	r.GET("/movies/:sid", h.Movie)
+	r.GET("/movies/imdb/:tt", h.MovieByIMDB)
+	r.GET("/match", h.Match)
 	r.GET("/movies/:sid/celebrities", h.Celebrities)
 	r.GET("/celebrities/:id", h.Celebrity)
 	r.GET("/photo/:sid", h.Photo)
@@ -30,11 +41,56 @@ func NewRouter(h *Handlers, b *book.Handlers, m *media.Handlers, debug bool) *gi
 
 	r.GET("/v2/book/search", b.Search)
 	r.GET("/v2/book/id/:sid", b.ByID)
+	r.GET("/v2/book/id/:sid/editions", b.Editions)
+	r.GET("/v2/book/id/:sid/comments", b.Comments)
+	r.GET("/v2/book/id/:sid/reviews", b.Reviews)
+	r.GET("/v2/book/id/:sid/annotations", b.Annotations)
 	r.GET("/v2/book/isbn/:isbn", b.ByISBN)
+	r.GET("/v2/book/series/:id", b.Series)
+	r.GET("/v2/book/author/:id", b.Author)
+	r.POST("/v2/book/batch", b.Batch)
+	r.GET("/v2/music/search", mu.Search)
+	r.GET("/v2/music/id/:sid", mu.ByID)
+	r.GET("/v2/game/search", gm.Search)
+	r.GET("/v2/game/id/:sid", gm.ByID)
+	r.GET("/v2/drama/search", dr.Search)
+	r.GET("/v2/drama/id/:sid", dr.ByID)
 	r.GET("/v2/media/hot/tv", m.HotTV)
 	r.GET("/v2/media/hot/movie", m.HotMovie)
 	r.GET("/v2/media/latest/movie", m.LatestMovie)
 	r.GET("/v2/media/high-rating/movie", m.HighRatingMovie)
+	r.GET("/v2/media/top250", m.Top250)
+	r.GET("/v2/media/browse", m.Browse)
+	r.GET("/v2/media/recent_hot/:subject", m.RecentHot)
+	r.GET("/v2/media/categories", m.Categories)
+	r.GET("/v2/media/snapshots", m.SnapshotLists)
+	r.GET("/v2/media/snapshots/:list", m.SnapshotIDs)
+	r.GET("/v2/media/snapshots/:list/diff", m.SnapshotDiff)
+	r.GET("/v2/media/snapshots/:list/:id", m.Snapshot)
+	r.GET("/v2/suggest", sg.Suggest)
+	r.GET("/graphql", gq.Query)
+	r.POST("/graphql", gq.Query)
+
+	if px != nil {
+		r.GET("/plex", px.Provider)
+		r.POST("/plex/library/metadata/matches", px.Match)
+		r.GET("/plex/library/metadata/:ratingKey", px.Metadata)
+	}
+
+	if tm != nil {
+		r.GET("/tmdb/3/configuration", tm.Configuration)
+		r.GET("/tmdb/3/search/movie", tm.SearchMovie)
+		r.GET("/tmdb/3/search/tv", tm.SearchTV)
+		r.GET("/tmdb/3/movie/:id", tm.Movie)
+		r.GET("/tmdb/3/movie/:id/credits", tm.Credits)
+		r.GET("/tmdb/3/movie/:id/images", tm.Images)
+		r.GET("/tmdb/3/movie/:id/external_ids", tm.ExternalIDs)
+		r.GET("/tmdb/3/tv/:id", tm.TV)
+		r.GET("/tmdb/3/tv/:id/credits", tm.Credits)
+		r.GET("/tmdb/3/tv/:id/images", tm.Images)
+		r.GET("/tmdb/3/tv/:id/external_ids", tm.ExternalIDs)
+		r.GET("/tmdb/t/p/:size/*path", tm.Image)
+	}
 
 	return r
 }
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/haigeek/douban-api-go/internal/book"
+	"github.com/haigeek/douban-api-go/internal/config"
+	"github.com/haigeek/douban-api-go/internal/drama"
+	"github.com/haigeek/douban-api-go/internal/game"
+	"github.com/haigeek/douban-api-go/internal/graphql"
+	"github.com/haigeek/douban-api-go/internal/media"
+	"github.com/haigeek/douban-api-go/internal/music"
+	"github.com/haigeek/douban-api-go/internal/plex"
+	"github.com/haigeek/douban-api-go/internal/suggest"
+	"github.com/haigeek/douban-api-go/internal/tmdb"
+)
+
+var reRouteParam = regexp.MustCompile(`[:*]([A-Za-z]+)`)
+
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	gq, err := graphql.NewHandlers(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("graphql.NewHandlers: %v", err)
+	}
+	r := NewRouter(
+		NewHandlers(nil, config.Config{}),
+		book.NewHandlers(nil),
+		media.NewHandlers(nil, nil),
+		suggest.NewHandlers(nil),
+		music.NewHandlers(nil),
+		game.NewHandlers(nil),
+		drama.NewHandlers(nil),
+		tmdb.NewHandlers(nil),
+		plex.NewHandlers(nil),
+		gq,
+		false,
+	)
+
+	routes := make(map[string]bool)
+	for _, route := range r.Routes() {
+		path := reRouteParam.ReplaceAllString(route.Path, "{$1}")
+		routes[strings.ToLower(route.Method)+" "+path] = true
+	}
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatalf("parse openapi.json: %v", err)
+	}
+	documented := make(map[string]bool)
+	for path, ops := range spec.Paths {
+		for method := range ops {
+			documented[method+" "+path] = true
+		}
+	}
+
+	var missing, stale []string
+	for route := range routes {
+		if !documented[route] {
+			missing = append(missing, route)
+		}
+	}
+	for route := range documented {
+		if !routes[route] {
+			stale = append(stale, route)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+	if len(missing) > 0 {
+		t.Errorf("routes missing from openapi.json:\n  %s", strings.Join(missing, "\n  "))
+	}
+	if len(stale) > 0 {
+		t.Errorf("openapi.json paths without a route:\n  %s", strings.Join(stale, "\n  "))
+	}
+}
+
+func TestOpenAPIServed(t *testing.T) {
+	r := NewRouter(NewHandlers(nil, config.Config{}), book.NewHandlers(nil), media.NewHandlers(nil, nil),
+		suggest.NewHandlers(nil), music.NewHandlers(nil), game.NewHandlers(nil), drama.NewHandlers(nil), nil, nil, nil, false)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if w.Code != http.StatusOK || !json.Valid(w.Body.Bytes()) {
+		t.Fatalf("GET /openapi.json = %d, valid JSON %v", w.Code, json.Valid(w.Body.Bytes()))
+	}
+}
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type Store struct {
+	path string
+	mu   sync.RWMutex
+	data map[string]string
+}
+
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, data: make(map[string]string)}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &s.data); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *Store) Get(key string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.data[key]
+	return v, ok
+}
+
+func (s *Store) Set(key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.data[key]; ok && old == value {
+		return nil
+	}
+	s.data[key] = value
+	return s.flush()
+}
+
+func (s *Store) SetMany(values map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := false
+	for key, value := range values {
+		if old, ok := s.data[key]; ok && old == value {
+			continue
+		}
+		s.data[key] = value
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return s.flush()
+}
+
+func (s *Store) flush() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(s.data, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,53 @@
+package subject
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSearchCount = 5
+	maxSearchCount     = 20
+)
+
+func Search[R any](c *gin.Context, search func(context.Context, string, int) (R, error)) {
+	q := c.Query("q")
+	if q == "" {
+		c.JSON(http.StatusOK, []any{})
+		return
+	}
+
+	count := defaultSearchCount
+	if raw, ok := c.GetQuery("count"); ok {
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "invalid count"})
+			return
+		}
+		count = v
+	}
+
+	if count > maxSearchCount {
+		c.Data(http.StatusBadRequest, "application/json; charset=utf-8", []byte(`{"message":"count不能大于20"}`))
+		return
+	}
+
+	result, err := search(c.Request.Context(), q, count)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func ByID[T any](c *gin.Context, get func(context.Context, string) (T, error)) {
+	info, err := get(c.Request.Context(), c.Param("sid"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, info)
+}
@@ -0,0 +1,77 @@
+package subject
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		target    string
+		status    int
+		body      string
+		wantCount int
+	}{
+		{"/search", http.StatusOK, `[]`, -1},
+		{"/search?q=x", http.StatusOK, `{"q":"x"}`, defaultSearchCount},
+		{"/search?q=x&count=20", http.StatusOK, `{"q":"x"}`, 20},
+		{"/search?q=x&count=abc", http.StatusBadRequest, `{"message":"invalid count"}`, -1},
+		{"/search?q=x&count=21", http.StatusBadRequest, `{"message":"count不能大于20"}`, -1},
+		{"/search?q=fail", http.StatusInternalServerError, `{"message":"upstream failed"}`, defaultSearchCount},
+	}
+	for _, tt := range tests {
+		gotCount := -1
+		search := func(_ context.Context, q string, count int) (map[string]string, error) {
+			gotCount = count
+			if q == "fail" {
+				return nil, errors.New("upstream failed")
+			}
+			return map[string]string{"q": q}, nil
+		}
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", tt.target, nil)
+		Search(c, search)
+
+		if w.Code != tt.status || w.Body.String() != tt.body {
+			t.Errorf("%s: got %d %s, want %d %s", tt.target, w.Code, w.Body.String(), tt.status, tt.body)
+		}
+		if gotCount != tt.wantCount {
+			t.Errorf("%s: search count = %d, want %d", tt.target, gotCount, tt.wantCount)
+		}
+	}
+}
+
+func TestByID(t *testing.T) {
+	get := func(_ context.Context, id string) (map[string]string, error) {
+		if id == "404" {
+			return nil, errors.New("not found")
+		}
+		return map[string]string{"id": id}, nil
+	}
+	tests := []struct {
+		sid    string
+		status int
+		body   string
+	}{
+		{"42", http.StatusOK, `{"id":"42"}`},
+		{"404", http.StatusInternalServerError, `{"message":"not found"}`},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/id/"+tt.sid, nil)
+		c.Params = gin.Params{{Key: "sid", Value: tt.sid}}
+		ByID(c, get)
+
+		if w.Code != tt.status || w.Body.String() != tt.body {
+			t.Errorf("sid %s: got %d %s, want %d %s", tt.sid, w.Code, w.Body.String(), tt.status, tt.body)
+		}
+	}
+}
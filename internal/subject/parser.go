@@ -0,0 +1,93 @@
+package subject
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var reSearchID = regexp.MustCompile(`sid: ([0-9]+?),`)
+
+type SearchResult struct {
+	ID      string
+	Title   string
+	Cover   string
+	Rating  float32
+	Cast    string
+	Summary string
+}
+
+func ParseSearchList[T any](doc *goquery.Document, count int, convert func(SearchResult) T) []T {
+	items := make([]T, 0)
+	doc.Find("div.result-list").First().Find(".result").Each(func(_ int, s *goquery.Selection) {
+		onclick, _ := s.Find("div.title a").Attr("onclick")
+		cover, _ := s.Find(".pic img").Attr("src")
+		items = append(items, convert(SearchResult{
+			ID:      CaptureGroup(reSearchID, onclick),
+			Title:   strings.TrimSpace(s.Find("div.title a").Text()),
+			Cover:   strings.TrimSpace(cover),
+			Rating:  ParseRating(s.Find(".rating_nums").Text()),
+			Cast:    strings.TrimSpace(s.Find(".subject-cast").Text()),
+			Summary: strings.TrimSpace(s.Find("p").Text()),
+		}))
+	})
+
+	if count > 0 && len(items) > count {
+		return items[:count]
+	}
+	return items
+}
+
+func ParseRating(text string) float32 {
+	f, err := strconv.ParseFloat(strings.TrimSpace(text), 32)
+	if err != nil {
+		return 0
+	}
+	return float32(f)
+}
+
+func ParseVotes(content *goquery.Selection) int {
+	votes, _ := strconv.Atoi(strings.TrimSpace(content.Find(`span[property="v:votes"]`).Text()))
+	return votes
+}
+
+func DefinitionList(sel *goquery.Selection) map[string]string {
+	attrs := make(map[string]string)
+	sel.Each(func(_ int, dt *goquery.Selection) {
+		key := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(dt.Text()), ":："))
+		if _, ok := attrs[key]; ok {
+			return
+		}
+		attrs[key] = strings.Join(strings.Fields(dt.NextFiltered("dd").Text()), " ")
+	})
+	return attrs
+}
+
+func SplitValues(raw string) []string {
+	res := make([]string, 0)
+	for _, it := range strings.Split(raw, "/") {
+		if v := strings.TrimSpace(it); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func FirstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func CaptureGroup(re *regexp.Regexp, text string) string {
+	m := re.FindStringSubmatch(text)
+	if len(m) < 2 {
+		return ""
+	}
+	return strings.TrimSpace(m[1])
+}
@@ -0,0 +1,62 @@
+package subject
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/haigeek/douban-api-go/internal/httpclient"
+)
+
+const cacheSize = 100
+
+type Service[T any] struct {
+	client    *httpclient.Client
+	cache     *expirable.LRU[string, T]
+	cat       string
+	detailURL string
+}
+
+func NewService[T any](client *httpclient.Client, cat, detailURL string) *Service[T] {
+	return &Service[T]{
+		client:    client,
+		cache:     expirable.NewLRU[string, T](cacheSize, nil, 10*time.Minute),
+		cat:       cat,
+		detailURL: detailURL,
+	}
+}
+
+func (s *Service[T]) Search(ctx context.Context, q string) (*goquery.Document, error) {
+	return s.fetchDocument(ctx, "https://www.douban.com/search", map[string]string{
+		"cat": s.cat,
+		"q":   q,
+	})
+}
+
+func (s *Service[T]) Get(ctx context.Context, id string, parse func(*goquery.Document, string) T) (T, error) {
+	if v, ok := s.cache.Get(id); ok {
+		return v, nil
+	}
+
+	doc, err := s.fetchDocument(ctx, fmt.Sprintf(s.detailURL, id), nil)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	info := parse(doc, id)
+	s.cache.Add(id, info)
+	return info, nil
+}
+
+func (s *Service[T]) fetchDocument(ctx context.Context, rawURL string, query map[string]string) (*goquery.Document, error) {
+	resp, err := s.client.Get(ctx, rawURL, query, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return goquery.NewDocumentFromReader(resp.Body)
+}
@@ -0,0 +1,43 @@
+package suggest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handlers struct {
+	service *Service
+}
+
+func NewHandlers(service *Service) *Handlers {
+	return &Handlers{service: service}
+}
+
+func (h *Handlers) Suggest(c *gin.Context) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		c.JSON(http.StatusOK, []Item{})
+		return
+	}
+
+	var (
+		result []Item
+		err    error
+	)
+	switch c.DefaultQuery("type", "movie") {
+	case "movie":
+		result, err = h.service.Movie(c.Request.Context(), q)
+	case "book":
+		result, err = h.service.Book(c.Request.Context(), q)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"message": "invalid type"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
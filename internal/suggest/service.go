@@ -0,0 +1,92 @@
+package suggest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/haigeek/douban-api-go/internal/httpclient"
+)
+
+const (
+	movieSuggestAPI = "https://movie.douban.com/j/subject_suggest"
+	bookSuggestAPI  = "https://book.douban.com/j/subject_suggest"
+	cacheSize       = 500
+)
+
+type Service struct {
+	client *httpclient.Client
+	cache  *expirable.LRU[string, []Item]
+}
+
+func NewService(client *httpclient.Client) *Service {
+	return &Service{
+		client: client,
+		cache:  expirable.NewLRU[string, []Item](cacheSize, nil, 30*time.Second),
+	}
+}
+
+func (s *Service) Movie(ctx context.Context, q string) ([]Item, error) {
+	cacheKey := fmt.Sprintf("movie_%s", q)
+	if v, ok := s.cache.Get(cacheKey); ok {
+		return v, nil
+	}
+
+	var raw []movieSuggestion
+	if err := s.fetchJSON(ctx, movieSuggestAPI, q, &raw); err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(raw))
+	for _, r := range raw {
+		items = append(items, Item{
+			ID:       r.ID,
+			Title:    r.Title,
+			SubTitle: r.SubTitle,
+			Year:     r.Year,
+			Episode:  r.Episode,
+			Cover:    r.Img,
+			Type:     r.Type,
+		})
+	}
+	s.cache.Add(cacheKey, items)
+	return items, nil
+}
+
+func (s *Service) Book(ctx context.Context, q string) ([]Item, error) {
+	cacheKey := fmt.Sprintf("book_%s", q)
+	if v, ok := s.cache.Get(cacheKey); ok {
+		return v, nil
+	}
+
+	var raw []bookSuggestion
+	if err := s.fetchJSON(ctx, bookSuggestAPI, q, &raw); err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(raw))
+	for _, r := range raw {
+		items = append(items, Item{
+			ID:       r.ID,
+			Title:    r.Title,
+			SubTitle: r.AuthorName,
+			Year:     r.Year,
+			Cover:    r.Pic,
+			Type:     "book",
+		})
+	}
+	s.cache.Add(cacheKey, items)
+	return items, nil
+}
+
+func (s *Service) fetchJSON(ctx context.Context, rawURL, q string, out any) error {
+	resp, err := s.client.Get(ctx, rawURL, map[string]string{"q": q}, true)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,30 @@
+package suggest
+
+type Item struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	SubTitle string `json:"sub_title"`
+	Year     string `json:"year"`
+	Episode  string `json:"episode"`
+	Cover    string `json:"cover"`
+	Type     string `json:"type"`
+}
+
+type movieSuggestion struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	SubTitle string `json:"sub_title"`
+	Year     string `json:"year"`
+	Episode  string `json:"episode"`
+	Img      string `json:"img"`
+	Type     string `json:"type"`
+}
+
+type bookSuggestion struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	AuthorName string `json:"author_name"`
+	Year       string `json:"year"`
+	Pic        string `json:"pic"`
+	Type       string `json:"type"`
+}
@@ -0,0 +1,364 @@
+package tmdb
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+const imageHost = "https://img2.doubanio.com"
+
+var (
+	reDoubanImage = regexp.MustCompile(`^https?://img[0-9]*\.doubanio\.com/view/([a-z_]+)/([a-z_]+)/public/([A-Za-z0-9][A-Za-z0-9._-]*)$`)
+)
+
+var movieGenres = map[string]int{
+	"动作":  28,
+	"冒险":  12,
+	"动画":  16,
+	"喜剧":  35,
+	"犯罪":  80,
+	"纪录片": 99,
+	"剧情":  18,
+	"家庭":  10751,
+	"奇幻":  14,
+	"历史":  36,
+	"古装":  36,
+	"恐怖":  27,
+	"音乐":  10402,
+	"歌舞":  10402,
+	"悬疑":  9648,
+	"爱情":  10749,
+	"科幻":  878,
+	"惊悚":  53,
+	"战争":  10752,
+	"西部":  37,
+}
+
+var tvGenres = map[string]int{
+	"动作":  10759,
+	"冒险":  10759,
+	"动画":  16,
+	"喜剧":  35,
+	"犯罪":  80,
+	"纪录片": 99,
+	"剧情":  18,
+	"家庭":  10751,
+	"儿童":  10762,
+	"悬疑":  9648,
+	"真人秀": 10764,
+	"科幻":  10765,
+	"奇幻":  10765,
+	"脱口秀": 10767,
+	"战争":  10768,
+	"西部":  37,
+}
+
+var countryCodes = map[string]string{
+	"中国大陆": "CN",
+	"中国":   "CN",
+	"中国香港": "HK",
+	"香港":   "HK",
+	"中国台湾": "TW",
+	"台湾":   "TW",
+	"美国":   "US",
+	"英国":   "GB",
+	"日本":   "JP",
+	"韩国":   "KR",
+	"法国":   "FR",
+	"德国":   "DE",
+	"意大利":  "IT",
+	"西班牙":  "ES",
+	"加拿大":  "CA",
+	"澳大利亚": "AU",
+	"印度":   "IN",
+	"泰国":   "TH",
+	"俄罗斯":  "RU",
+}
+
+var languageCodes = map[string]Language{
+	"汉语普通话": {ISO6391: "zh", EnglishName: "Mandarin"},
+	"普通话":   {ISO6391: "zh", EnglishName: "Mandarin"},
+	"粤语":    {ISO6391: "cn", EnglishName: "Cantonese"},
+	"英语":    {ISO6391: "en", EnglishName: "English"},
+	"日语":    {ISO6391: "ja", EnglishName: "Japanese"},
+	"韩语":    {ISO6391: "ko", EnglishName: "Korean"},
+	"法语":    {ISO6391: "fr", EnglishName: "French"},
+	"德语":    {ISO6391: "de", EnglishName: "German"},
+	"西班牙语":  {ISO6391: "es", EnglishName: "Spanish"},
+	"意大利语":  {ISO6391: "it", EnglishName: "Italian"},
+	"俄语":    {ISO6391: "ru", EnglishName: "Russian"},
+	"泰语":    {ISO6391: "th", EnglishName: "Thai"},
+	"印地语":   {ISO6391: "hi", EnglishName: "Hindi"},
+	"葡萄牙语":  {ISO6391: "pt", EnglishName: "Portuguese"},
+}
+
+func parseID(raw string) (int, bool) {
+	id, err := strconv.Atoi(raw)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+func isTV(info movie.MovieInfo) bool {
+	return info.Episodes != ""
+}
+
+func toMovieResult(m movie.Movie) (MovieResult, bool) {
+	id, ok := parseID(m.SID)
+	if !ok {
+		return MovieResult{}, false
+	}
+	return MovieResult{
+		ID:            id,
+		Title:         m.Name,
+		OriginalTitle: m.Name,
+		ReleaseDate:   yearDate(m.Year),
+		PosterPath:    imagePath(m.Img),
+		GenreIDs:      []int{},
+		VoteAverage:   parseRating(m.Rating),
+	}, true
+}
+
+func toTVResult(m movie.Movie) (TVResult, bool) {
+	id, ok := parseID(m.SID)
+	if !ok {
+		return TVResult{}, false
+	}
+	return TVResult{
+		ID:            id,
+		Name:          m.Name,
+		OriginalName:  m.Name,
+		FirstAirDate:  yearDate(m.Year),
+		PosterPath:    imagePath(m.Img),
+		GenreIDs:      []int{},
+		OriginCountry: []string{},
+		VoteAverage:   parseRating(m.Rating),
+	}, true
+}
+
+func toMovieDetail(id int, info movie.MovieInfo) MovieDetail {
+	languages := toLanguages(info.Language)
+	return MovieDetail{
+		ID:                  id,
+		IMDBID:              optional(info.IMDB),
+		Title:               info.Name,
+		OriginalTitle:       originalTitle(info),
+		OriginalLanguage:    originalLanguage(languages),
+		Overview:            info.Intro,
+		Status:              "Released",
+		ReleaseDate:         releaseDate(info),
+		Runtime:             movie.FirstNumber(info.Duration),
+		Genres:              toGenres(info.Genre, movieGenres),
+		ProductionCountries: toCountries(info.Country),
+		SpokenLanguages:     languages,
+		PosterPath:          imagePath(info.Img),
+		VoteAverage:         parseRating(info.Rating),
+		VoteCount:           movie.FirstNumber(info.Votes),
+	}
+}
+
+func toTVDetail(id int, info movie.MovieInfo) TVDetail {
+	languages := toLanguages(info.Language)
+	countries := toCountries(info.Country)
+	origin := make([]string, 0, len(countries))
+	for _, c := range countries {
+		if c.ISO31661 != "" {
+			origin = append(origin, c.ISO31661)
+		}
+	}
+	runTime := []int{}
+	if n := movie.FirstNumber(info.Duration); n > 0 {
+		runTime = append(runTime, n)
+	}
+	return TVDetail{
+		ID:                  id,
+		Name:                info.Name,
+		OriginalName:        originalTitle(info),
+		OriginalLanguage:    originalLanguage(languages),
+		Overview:            info.Intro,
+		Status:              "Returning Series",
+		FirstAirDate:        releaseDate(info),
+		EpisodeRunTime:      runTime,
+		NumberOfEpisodes:    movie.FirstNumber(info.Episodes),
+		NumberOfSeasons:     1,
+		Genres:              toGenres(info.Genre, tvGenres),
+		OriginCountry:       origin,
+		ProductionCountries: countries,
+		SpokenLanguages:     languages,
+		PosterPath:          imagePath(info.Img),
+		VoteAverage:         parseRating(info.Rating),
+		VoteCount:           movie.FirstNumber(info.Votes),
+	}
+}
+
+func toCredits(id int, info movie.MovieInfo, celebrities []movie.Celebrity) Credits {
+	credits := Credits{ID: id, Cast: []CastMember{}, Crew: []CrewMember{}}
+	for i, c := range celebrities {
+		celebrityID, _ := parseID(c.ID)
+		creditID := fmt.Sprintf("douban-%s-%d", info.SID, i)
+		switch c.RoleType {
+		case "导演":
+			credits.Crew = append(credits.Crew, CrewMember{
+				ID:                 celebrityID,
+				Name:               c.Name,
+				OriginalName:       c.Name,
+				Job:                "Director",
+				Department:         "Directing",
+				CreditID:           creditID,
+				ProfilePath:        imagePath(c.Img),
+				KnownForDepartment: "Directing",
+			})
+		case "演员", "配音":
+			character := c.Role
+			if character == c.RoleType {
+				character = ""
+			}
+			credits.Cast = append(credits.Cast, CastMember{
+				ID:                 celebrityID,
+				Name:               c.Name,
+				OriginalName:       c.Name,
+				Character:          character,
+				CreditID:           creditID,
+				Order:              len(credits.Cast),
+				ProfilePath:        imagePath(c.Img),
+				KnownForDepartment: "Acting",
+			})
+		}
+	}
+	for i, name := range movie.SplitList(info.Writer) {
+		credits.Crew = append(credits.Crew, CrewMember{
+			Name:               name,
+			OriginalName:       name,
+			Job:                "Screenplay",
+			Department:         "Writing",
+			CreditID:           fmt.Sprintf("douban-%s-w%d", info.SID, i),
+			KnownForDepartment: "Writing",
+		})
+	}
+	return credits
+}
+
+func toImages(id int, info movie.MovieInfo, photos []movie.Photo) Images {
+	images := Images{ID: id, Backdrops: []Image{}, Posters: []Image{}, Logos: []Image{}}
+	if p := imagePath(info.Img); p != nil {
+		images.Posters = append(images.Posters, Image{FilePath: *p, Width: 1000, Height: 1500, AspectRatio: 0.667})
+	}
+	for _, photo := range photos {
+		p := imagePath(photo.Large)
+		if p == nil {
+			continue
+		}
+		width, _ := strconv.Atoi(photo.Width)
+		height, _ := strconv.Atoi(photo.Height)
+		aspect := 0.0
+		if height > 0 {
+			aspect = float64(width) / float64(height)
+		}
+		images.Backdrops = append(images.Backdrops, Image{FilePath: *p, Width: width, Height: height, AspectRatio: aspect})
+	}
+	return images
+}
+
+func imagePath(rawURL string) *string {
+	m := reDoubanImage.FindStringSubmatch(strings.TrimSpace(rawURL))
+	if len(m) < 4 {
+		return nil
+	}
+	p := "/" + m[1] + "/" + m[2] + "/" + m[3]
+	return &p
+}
+
+func imageURL(size, path string) (string, bool) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != 3 {
+		return "", false
+	}
+	if !reDoubanImage.MatchString(imageHost + "/view/" + parts[0] + "/" + parts[1] + "/public/" + parts[2]) {
+		return "", false
+	}
+	kind, variant, file := parts[0], parts[1], parts[2]
+	if kind == "photo" {
+		switch size {
+		case "w45", "w92", "w154", "w185":
+		default:
+			variant = "l"
+		}
+	}
+	return imageHost + "/view/" + kind + "/" + variant + "/public/" + file, true
+}
+
+func toGenres(raw string, table map[string]int) []Genre {
+	genres := make([]Genre, 0)
+	for _, name := range movie.SplitList(raw) {
+		genres = append(genres, Genre{ID: table[name], Name: name})
+	}
+	return genres
+}
+
+func toCountries(raw string) []Country {
+	countries := make([]Country, 0)
+	for _, name := range movie.SplitList(raw) {
+		countries = append(countries, Country{ISO31661: countryCodes[name], Name: name})
+	}
+	return countries
+}
+
+func toLanguages(raw string) []Language {
+	languages := make([]Language, 0)
+	for _, name := range movie.SplitList(raw) {
+		lang := languageCodes[name]
+		lang.Name = name
+		languages = append(languages, lang)
+	}
+	return languages
+}
+
+func originalLanguage(languages []Language) string {
+	for _, l := range languages {
+		if l.ISO6391 != "" {
+			return l.ISO6391
+		}
+	}
+	return ""
+}
+
+func originalTitle(info movie.MovieInfo) string {
+	if info.OriginalName != "" {
+		return info.OriginalName
+	}
+	return info.Name
+}
+
+func releaseDate(info movie.MovieInfo) string {
+	if d := movie.FirstDate(info.Screen); d != "" {
+		return d
+	}
+	return yearDate(info.Year)
+}
+
+func yearDate(year string) string {
+	if len(year) != 4 {
+		return ""
+	}
+	return year + "-01-01"
+}
+
+func parseRating(raw string) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
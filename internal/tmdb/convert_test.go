@@ -0,0 +1,177 @@
+package tmdb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+func ptr(s string) *string { return &s }
+
+func TestImagePath(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want *string
+	}{
+		{"https://img2.doubanio.com/view/photo/s_ratio_poster/public/p2561716440.jpg", ptr("/photo/s_ratio_poster/p2561716440.jpg")},
+		{" http://img9.doubanio.com/view/celebrity/raw/public/p33525.webp ", ptr("/celebrity/raw/p33525.webp")},
+		{"https://img1.doubanio.com/view/subject/l/public/s1070959.jpg", ptr("/subject/l/s1070959.jpg")},
+		{"https://img3.doubanio.com/f/movie/default_large.png", nil},
+		{"https://example.com/view/photo/l/public/p1.jpg", nil},
+		{"https://img2.doubanio.com/view/photo/l/public/../p1.jpg", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := imagePath(tt.raw); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("imagePath(%q) = %v, want %v", tt.raw, deref(got), deref(tt.want))
+		}
+	}
+}
+
+func TestImageURL(t *testing.T) {
+	tests := []struct {
+		size, path string
+		want       string
+		ok         bool
+	}{
+		{"w185", "/photo/s_ratio_poster/p1.jpg", "https://img2.doubanio.com/view/photo/s_ratio_poster/public/p1.jpg", true},
+		{"w500", "/photo/s_ratio_poster/p1.jpg", "https://img2.doubanio.com/view/photo/l/public/p1.jpg", true},
+		{"original", "/photo/s_ratio_poster/p1.jpg", "https://img2.doubanio.com/view/photo/l/public/p1.jpg", true},
+		{"w500", "/celebrity/raw/p2.jpg", "https://img2.doubanio.com/view/celebrity/raw/public/p2.jpg", true},
+		{"w500", "/photo/l", "", false},
+		{"w500", "/photo/l/p1.jpg/extra", "", false},
+		{"w500", "/photo/l/..", "", false},
+		{"w500", "/PHOTO/l/p1.jpg", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := imageURL(tt.size, tt.path)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("imageURL(%q, %q) = %q, %v, want %q, %v", tt.size, tt.path, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestToCredits(t *testing.T) {
+	info := movie.MovieInfo{SID: "1292052", Writer: "弗兰克·德拉邦特 / 斯蒂芬·金 / "}
+	celebrities := []movie.Celebrity{
+		{ID: "1047973", Name: "弗兰克·德拉邦特", RoleType: "导演", Role: "导演", Img: "https://img2.doubanio.com/view/celebrity/raw/public/p230.jpg"},
+		{ID: "1054521", Name: "蒂姆·罗宾斯", RoleType: "演员", Role: "饰 安迪·杜佛兰"},
+		{ID: "1054534", Name: "摩根·弗里曼", RoleType: "演员", Role: "演员"},
+		{ID: "", Name: "配音演员", RoleType: "配音", Role: "配音"},
+		{ID: "1000001", Name: "制片人", RoleType: "制片人", Role: "制片人"},
+	}
+	want := Credits{
+		ID: 1292052,
+		Cast: []CastMember{
+			{ID: 1054521, Name: "蒂姆·罗宾斯", OriginalName: "蒂姆·罗宾斯", Character: "饰 安迪·杜佛兰", CreditID: "douban-1292052-1", Order: 0, KnownForDepartment: "Acting"},
+			{ID: 1054534, Name: "摩根·弗里曼", OriginalName: "摩根·弗里曼", CreditID: "douban-1292052-2", Order: 1, KnownForDepartment: "Acting"},
+			{ID: 0, Name: "配音演员", OriginalName: "配音演员", CreditID: "douban-1292052-3", Order: 2, KnownForDepartment: "Acting"},
+		},
+		Crew: []CrewMember{
+			{ID: 1047973, Name: "弗兰克·德拉邦特", OriginalName: "弗兰克·德拉邦特", Job: "Director", Department: "Directing", CreditID: "douban-1292052-0", ProfilePath: ptr("/celebrity/raw/p230.jpg"), KnownForDepartment: "Directing"},
+			{Name: "弗兰克·德拉邦特", OriginalName: "弗兰克·德拉邦特", Job: "Screenplay", Department: "Writing", CreditID: "douban-1292052-w0", KnownForDepartment: "Writing"},
+			{Name: "斯蒂芬·金", OriginalName: "斯蒂芬·金", Job: "Screenplay", Department: "Writing", CreditID: "douban-1292052-w1", KnownForDepartment: "Writing"},
+		},
+	}
+	if got := toCredits(1292052, info, celebrities); !reflect.DeepEqual(got, want) {
+		t.Errorf("toCredits =\n%+v\nwant\n%+v", got, want)
+	}
+
+	empty := toCredits(1, movie.MovieInfo{SID: "1"}, nil)
+	if empty.Cast == nil || empty.Crew == nil || len(empty.Cast)+len(empty.Crew) != 0 {
+		t.Errorf("toCredits with no celebrities = %+v, want empty non-nil lists", empty)
+	}
+}
+
+func TestToMovieDetail(t *testing.T) {
+	tests := []struct {
+		name string
+		info movie.MovieInfo
+		want MovieDetail
+	}{
+		{
+			name: "full",
+			info: movie.MovieInfo{
+				SID:          "1292052",
+				Name:         "肖申克的救赎",
+				OriginalName: "The Shawshank Redemption",
+				Rating:       "9.7",
+				Votes:        "3,000,000人评价",
+				Img:          "https://img2.doubanio.com/view/photo/s_ratio_poster/public/p480747492.jpg",
+				Year:         "1994",
+				Intro:        "希望让人自由。",
+				Genre:        "剧情 / 犯罪",
+				Country:      "美国",
+				Language:     "英语 / 火星语",
+				Screen:       "1994-09-10(多伦多电影节) / 1994-10-14(美国)",
+				Duration:     "142分钟",
+				IMDB:         "tt0111161",
+			},
+			want: MovieDetail{
+				ID:                  1292052,
+				IMDBID:              ptr("tt0111161"),
+				Title:               "肖申克的救赎",
+				OriginalTitle:       "The Shawshank Redemption",
+				OriginalLanguage:    "en",
+				Overview:            "希望让人自由。",
+				Status:              "Released",
+				ReleaseDate:         "1994-09-10",
+				Runtime:             142,
+				Genres:              []Genre{{ID: 18, Name: "剧情"}, {ID: 80, Name: "犯罪"}},
+				ProductionCountries: []Country{{ISO31661: "US", Name: "美国"}},
+				SpokenLanguages:     []Language{{ISO6391: "en", Name: "英语", EnglishName: "English"}, {Name: "火星语"}},
+				PosterPath:          ptr("/photo/s_ratio_poster/p480747492.jpg"),
+				VoteAverage:         9.7,
+				VoteCount:           3,
+			},
+		},
+		{
+			name: "sparse",
+			info: movie.MovieInfo{SID: "42", Name: "无名", Year: "2020", Rating: "暂无"},
+			want: MovieDetail{
+				ID:                  42,
+				Title:               "无名",
+				OriginalTitle:       "无名",
+				Status:              "Released",
+				ReleaseDate:         "2020-01-01",
+				Genres:              []Genre{},
+				ProductionCountries: []Country{},
+				SpokenLanguages:     []Language{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, _ := parseID(tt.info.SID)
+			if got := toMovieDetail(id, tt.info); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toMovieDetail =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteKind(t *testing.T) {
+	tests := []struct {
+		path string
+		info movie.MovieInfo
+		want bool
+	}{
+		{"/tmdb/3/movie/:id", movie.MovieInfo{}, true},
+		{"/tmdb/3/movie/:id/credits", movie.MovieInfo{Episodes: "24"}, false},
+		{"/tmdb/3/tv/:id", movie.MovieInfo{Episodes: "24"}, true},
+		{"/tmdb/3/tv/:id/images", movie.MovieInfo{}, false},
+	}
+	for _, tt := range tests {
+		if got := isTVRoute(tt.path) == isTV(tt.info); got != tt.want {
+			t.Errorf("%s with episodes %q matches = %v, want %v", tt.path, tt.info.Episodes, got, tt.want)
+		}
+	}
+}
+
+func deref(p *string) string {
+	if p == nil {
+		return "<nil>"
+	}
+	return *p
+}
@@ -0,0 +1,242 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+)
+
+const (
+	catMovie = "电影"
+	catTV    = "电视剧"
+)
+
+type Handlers struct {
+	movie *movie.Service
+}
+
+func NewHandlers(movieService *movie.Service) *Handlers {
+	return &Handlers{movie: movieService}
+}
+
+func (h *Handlers) Configuration(c *gin.Context) {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	base := fmt.Sprintf("%s://%s/tmdb/t/p/", scheme, c.Request.Host)
+	c.JSON(http.StatusOK, Configuration{Images: ImageConfiguration{
+		BaseURL:       base,
+		SecureBaseURL: base,
+		BackdropSizes: []string{"w300", "w780", "w1280", "original"},
+		PosterSizes:   []string{"w92", "w154", "w185", "w342", "w500", "w780", "original"},
+		ProfileSizes:  []string{"w45", "w185", "h632", "original"},
+		StillSizes:    []string{"w92", "w185", "w300", "original"},
+		LogoSizes:     []string{"w45", "w92", "w154", "w185", "w300", "w500", "original"},
+	}})
+}
+
+func (h *Handlers) SearchMovie(c *gin.Context) {
+	movies, ok := h.search(c, catMovie, c.DefaultQuery("primary_release_year", c.Query("year")))
+	if !ok {
+		return
+	}
+	results := make([]MovieResult, 0, len(movies))
+	for _, m := range movies {
+		if r, ok := toMovieResult(m); ok {
+			results = append(results, r)
+		}
+	}
+	c.JSON(http.StatusOK, newSearchResponse(c, results))
+}
+
+func (h *Handlers) SearchTV(c *gin.Context) {
+	movies, ok := h.search(c, catTV, c.DefaultQuery("first_air_date_year", c.Query("year")))
+	if !ok {
+		return
+	}
+	results := make([]TVResult, 0, len(movies))
+	for _, m := range movies {
+		if r, ok := toTVResult(m); ok {
+			results = append(results, r)
+		}
+	}
+	c.JSON(http.StatusOK, newSearchResponse(c, results))
+}
+
+func (h *Handlers) search(c *gin.Context, cat, year string) ([]movie.Movie, bool) {
+	q := strings.TrimSpace(c.Query("query"))
+	if q == "" {
+		return []movie.Movie{}, true
+	}
+	movies, err := h.movie.Search(c.Request.Context(), q, 0, "")
+	if err != nil {
+		writeError(c, http.StatusInternalServerError, 11, err.Error())
+		return nil, false
+	}
+	filtered := make([]movie.Movie, 0, len(movies))
+	for _, m := range movies {
+		if m.Cat != cat || (year != "" && m.Year != year) {
+			continue
+		}
+		filtered = append(filtered, m)
+	}
+	return filtered, true
+}
+
+func (h *Handlers) Movie(c *gin.Context) {
+	id, info, ok := h.subject(c)
+	if !ok {
+		return
+	}
+	detail := toMovieDetail(id, info)
+	for _, part := range appendToResponse(c) {
+		switch part {
+		case "credits":
+			credits := h.credits(c, id, info)
+			detail.Credits = &credits
+		case "images":
+			images := h.images(c, id, info)
+			detail.Images = &images
+		case "external_ids":
+			detail.ExternalIDs = &ExternalIDs{ID: id, IMDBID: optional(info.IMDB)}
+		}
+	}
+	c.JSON(http.StatusOK, detail)
+}
+
+func (h *Handlers) TV(c *gin.Context) {
+	id, info, ok := h.subject(c)
+	if !ok {
+		return
+	}
+	detail := toTVDetail(id, info)
+	for _, part := range appendToResponse(c) {
+		switch part {
+		case "credits", "aggregate_credits":
+			credits := h.credits(c, id, info)
+			detail.Credits = &credits
+		case "images":
+			images := h.images(c, id, info)
+			detail.Images = &images
+		case "external_ids":
+			detail.ExternalIDs = &ExternalIDs{ID: id, IMDBID: optional(info.IMDB)}
+		}
+	}
+	c.JSON(http.StatusOK, detail)
+}
+
+func (h *Handlers) Credits(c *gin.Context) {
+	id, info, ok := h.subject(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, h.credits(c, id, info))
+}
+
+func (h *Handlers) Images(c *gin.Context) {
+	id, info, ok := h.subject(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, h.images(c, id, info))
+}
+
+func (h *Handlers) ExternalIDs(c *gin.Context) {
+	id, info, ok := h.subject(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, ExternalIDs{ID: id, IMDBID: optional(info.IMDB)})
+}
+
+func (h *Handlers) Image(c *gin.Context) {
+	rawURL, ok := imageURL(c.Param("size"), c.Param("path"))
+	if !ok {
+		writeError(c, http.StatusNotFound, 34, "The resource you requested could not be found.")
+		return
+	}
+	resp, body, err := h.movie.ProxyImage(c.Request.Context(), rawURL)
+	if err != nil {
+		writeError(c, http.StatusInternalServerError, 11, err.Error())
+		return
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.Data(resp.StatusCode, contentType, body)
+}
+
+func (h *Handlers) subject(c *gin.Context) (int, movie.MovieInfo, bool) {
+	id, ok := parseID(c.Param("id"))
+	if !ok {
+		writeError(c, http.StatusNotFound, 34, "The resource you requested could not be found.")
+		return 0, movie.MovieInfo{}, false
+	}
+	info, err := h.movie.GetMovieInfo(c.Request.Context(), fmt.Sprint(id), "l")
+	if err != nil {
+		writeError(c, http.StatusInternalServerError, 11, err.Error())
+		return 0, movie.MovieInfo{}, false
+	}
+	if info.Name == "" || isTVRoute(c.FullPath()) != isTV(info) {
+		writeError(c, http.StatusNotFound, 34, "The resource you requested could not be found.")
+		return 0, movie.MovieInfo{}, false
+	}
+	return id, info, true
+}
+
+func isTVRoute(path string) bool {
+	return strings.HasPrefix(path, "/tmdb/3/tv/")
+}
+
+func (h *Handlers) credits(c *gin.Context, id int, info movie.MovieInfo) Credits {
+	celebrities, err := h.movie.GetCelebrities(c.Request.Context(), info.SID)
+	if err != nil || len(celebrities) == 0 {
+		celebrities = info.Celebrities
+	}
+	return toCredits(id, info, celebrities)
+}
+
+func (h *Handlers) images(c *gin.Context, id int, info movie.MovieInfo) Images {
+	photos, err := h.movie.GetWallpaper(c.Request.Context(), info.SID)
+	if err != nil {
+		photos = nil
+	}
+	return toImages(id, info, photos)
+}
+
+func appendToResponse(c *gin.Context) []string {
+	return splitComma(c.Query("append_to_response"))
+}
+
+func splitComma(raw string) []string {
+	list := make([]string, 0)
+	for _, it := range strings.Split(raw, ",") {
+		if v := strings.TrimSpace(it); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func newSearchResponse[T any](c *gin.Context, results []T) SearchResponse[T] {
+	resp := SearchResponse[T]{Page: 1, Results: results, TotalPages: 1, TotalResults: len(results)}
+	if page, err := strconv.Atoi(c.Query("page")); err == nil && page > 1 {
+		resp.Page = page
+		resp.Results = []T{}
+	}
+	return resp
+}
+
+func writeError(c *gin.Context, status, code int, message string) {
+	c.JSON(status, Error{StatusCode: code, StatusMessage: message, Success: false})
+}
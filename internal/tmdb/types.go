@@ -0,0 +1,179 @@
+package tmdb
+
+type Genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Country struct {
+	ISO31661 string `json:"iso_3166_1"`
+	Name     string `json:"name"`
+}
+
+type Language struct {
+	ISO6391     string `json:"iso_639_1"`
+	Name        string `json:"name"`
+	EnglishName string `json:"english_name"`
+}
+
+type SearchResponse[T any] struct {
+	Page         int `json:"page"`
+	Results      []T `json:"results"`
+	TotalPages   int `json:"total_pages"`
+	TotalResults int `json:"total_results"`
+}
+
+type MovieResult struct {
+	ID            int     `json:"id"`
+	Title         string  `json:"title"`
+	OriginalTitle string  `json:"original_title"`
+	ReleaseDate   string  `json:"release_date"`
+	Overview      string  `json:"overview"`
+	PosterPath    *string `json:"poster_path"`
+	BackdropPath  *string `json:"backdrop_path"`
+	GenreIDs      []int   `json:"genre_ids"`
+	VoteAverage   float64 `json:"vote_average"`
+	VoteCount     int     `json:"vote_count"`
+	Popularity    float64 `json:"popularity"`
+	Adult         bool    `json:"adult"`
+	Video         bool    `json:"video"`
+}
+
+type TVResult struct {
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	OriginalName  string   `json:"original_name"`
+	FirstAirDate  string   `json:"first_air_date"`
+	Overview      string   `json:"overview"`
+	PosterPath    *string  `json:"poster_path"`
+	BackdropPath  *string  `json:"backdrop_path"`
+	GenreIDs      []int    `json:"genre_ids"`
+	OriginCountry []string `json:"origin_country"`
+	VoteAverage   float64  `json:"vote_average"`
+	VoteCount     int      `json:"vote_count"`
+	Popularity    float64  `json:"popularity"`
+}
+
+type MovieDetail struct {
+	ID                  int          `json:"id"`
+	IMDBID              *string      `json:"imdb_id"`
+	Title               string       `json:"title"`
+	OriginalTitle       string       `json:"original_title"`
+	OriginalLanguage    string       `json:"original_language"`
+	Overview            string       `json:"overview"`
+	Tagline             string       `json:"tagline"`
+	Status              string       `json:"status"`
+	ReleaseDate         string       `json:"release_date"`
+	Runtime             int          `json:"runtime"`
+	Genres              []Genre      `json:"genres"`
+	ProductionCountries []Country    `json:"production_countries"`
+	SpokenLanguages     []Language   `json:"spoken_languages"`
+	PosterPath          *string      `json:"poster_path"`
+	BackdropPath        *string      `json:"backdrop_path"`
+	Homepage            string       `json:"homepage"`
+	VoteAverage         float64      `json:"vote_average"`
+	VoteCount           int          `json:"vote_count"`
+	Popularity          float64      `json:"popularity"`
+	Adult               bool         `json:"adult"`
+	Video               bool         `json:"video"`
+	Credits             *Credits     `json:"credits,omitempty"`
+	Images              *Images      `json:"images,omitempty"`
+	ExternalIDs         *ExternalIDs `json:"external_ids,omitempty"`
+}
+
+type TVDetail struct {
+	ID                  int          `json:"id"`
+	Name                string       `json:"name"`
+	OriginalName        string       `json:"original_name"`
+	OriginalLanguage    string       `json:"original_language"`
+	Overview            string       `json:"overview"`
+	Tagline             string       `json:"tagline"`
+	Status              string       `json:"status"`
+	FirstAirDate        string       `json:"first_air_date"`
+	EpisodeRunTime      []int        `json:"episode_run_time"`
+	NumberOfEpisodes    int          `json:"number_of_episodes"`
+	NumberOfSeasons     int          `json:"number_of_seasons"`
+	Genres              []Genre      `json:"genres"`
+	OriginCountry       []string     `json:"origin_country"`
+	ProductionCountries []Country    `json:"production_countries"`
+	SpokenLanguages     []Language   `json:"spoken_languages"`
+	PosterPath          *string      `json:"poster_path"`
+	BackdropPath        *string      `json:"backdrop_path"`
+	Homepage            string       `json:"homepage"`
+	VoteAverage         float64      `json:"vote_average"`
+	VoteCount           int          `json:"vote_count"`
+	Popularity          float64      `json:"popularity"`
+	Credits             *Credits     `json:"credits,omitempty"`
+	Images              *Images      `json:"images,omitempty"`
+	ExternalIDs         *ExternalIDs `json:"external_ids,omitempty"`
+}
+
+type ExternalIDs struct {
+	ID     int     `json:"id"`
+	IMDBID *string `json:"imdb_id"`
+}
+
+type Credits struct {
+	ID   int          `json:"id"`
+	Cast []CastMember `json:"cast"`
+	Crew []CrewMember `json:"crew"`
+}
+
+type CastMember struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	OriginalName       string  `json:"original_name"`
+	Character          string  `json:"character"`
+	CreditID           string  `json:"credit_id"`
+	Order              int     `json:"order"`
+	ProfilePath        *string `json:"profile_path"`
+	KnownForDepartment string  `json:"known_for_department"`
+}
+
+type CrewMember struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	OriginalName       string  `json:"original_name"`
+	Job                string  `json:"job"`
+	Department         string  `json:"department"`
+	CreditID           string  `json:"credit_id"`
+	ProfilePath        *string `json:"profile_path"`
+	KnownForDepartment string  `json:"known_for_department"`
+}
+
+type Images struct {
+	ID        int     `json:"id"`
+	Backdrops []Image `json:"backdrops"`
+	Posters   []Image `json:"posters"`
+	Logos     []Image `json:"logos"`
+}
+
+type Image struct {
+	FilePath    string  `json:"file_path"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	AspectRatio float64 `json:"aspect_ratio"`
+	ISO6391     *string `json:"iso_639_1"`
+	VoteAverage float64 `json:"vote_average"`
+	VoteCount   int     `json:"vote_count"`
+}
+
+type Configuration struct {
+	Images ImageConfiguration `json:"images"`
+}
+
+type ImageConfiguration struct {
+	BaseURL       string   `json:"base_url"`
+	SecureBaseURL string   `json:"secure_base_url"`
+	BackdropSizes []string `json:"backdrop_sizes"`
+	PosterSizes   []string `json:"poster_sizes"`
+	ProfileSizes  []string `json:"profile_sizes"`
+	StillSizes    []string `json:"still_sizes"`
+	LogoSizes     []string `json:"logo_sizes"`
+}
+
+type Error struct {
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Success       bool   `json:"success"`
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) SearchBooks(ctx context.Context, q string, start, count int, full bool) (DoubanBookResult, error) {
+	query := url.Values{"q": {q}}
+	setInt(query, "start", start)
+	setInt(query, "count", count)
+	if full {
+		query.Set("type", "full")
+	}
+	var result DoubanBookResult
+	err := c.getJSON(ctx, "/v2/book/search", query, &result)
+	return result, err
+}
+
+func (c *Client) Book(ctx context.Context, id string) (DoubanBook, error) {
+	var result DoubanBook
+	err := c.getJSON(ctx, "/v2/book/id/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) BookByISBN(ctx context.Context, isbn string) (DoubanBook, error) {
+	var result DoubanBook
+	err := c.getJSON(ctx, "/v2/book/isbn/"+url.PathEscape(isbn), nil, &result)
+	return result, err
+}
+
+func (c *Client) BookOPF(ctx context.Context, id string, version int) ([]byte, error) {
+	query := url.Values{}
+	setInt(query, "version", version)
+	return c.do(ctx, http.MethodGet, "/v2/book/id/"+url.PathEscape(id)+".opf", query, nil)
+}
+
+func (c *Client) BookEditions(ctx context.Context, id string) ([]BookEdition, error) {
+	var result []BookEdition
+	err := c.getJSON(ctx, "/v2/book/id/"+url.PathEscape(id)+"/editions", nil, &result)
+	return result, err
+}
+
+func (c *Client) BookComments(ctx context.Context, id, sort string, start int) (CommentList, error) {
+	return c.commentList(ctx, id, "comments", sort, start)
+}
+
+func (c *Client) BookReviews(ctx context.Context, id, sort string, start int) (CommentList, error) {
+	return c.commentList(ctx, id, "reviews", sort, start)
+}
+
+func (c *Client) BookAnnotations(ctx context.Context, id, sort string, start int) (CommentList, error) {
+	return c.commentList(ctx, id, "annotations", sort, start)
+}
+
+func (c *Client) commentList(ctx context.Context, id, kind, sort string, start int) (CommentList, error) {
+	query := url.Values{}
+	if sort != "" {
+		query.Set("sort", sort)
+	}
+	setInt(query, "start", start)
+	var result CommentList
+	err := c.getJSON(ctx, "/v2/book/id/"+url.PathEscape(id)+"/"+kind, query, &result)
+	return result, err
+}
+
+func (c *Client) BookSeries(ctx context.Context, id string) (BookSeries, error) {
+	var result BookSeries
+	err := c.getJSON(ctx, "/v2/book/series/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) BookAuthor(ctx context.Context, id string, start int) (Author, error) {
+	query := url.Values{}
+	setInt(query, "start", start)
+	var result Author
+	err := c.getJSON(ctx, "/v2/book/author/"+url.PathEscape(id), query, &result)
+	return result, err
+}
+
+func (c *Client) BatchBooks(ctx context.Context, req BatchRequest) ([]BatchResult, error) {
+	var result batchResponse
+	err := c.doJSON(ctx, http.MethodPost, "/v2/book/batch", nil, req, &result)
+	return result.Results, err
+}
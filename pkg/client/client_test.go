@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type recorded struct {
+	method string
+	path   string
+	query  string
+	body   string
+	user   string
+	pass   string
+	agent  string
+}
+
+func newTestServer(t *testing.T, status int, response string) (*httptest.Server, *recorded) {
+	t.Helper()
+	rec := &recorded{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rec.method = r.Method
+		rec.path = r.URL.EscapedPath()
+		rec.query = r.URL.RawQuery
+		rec.body = string(body)
+		rec.user, rec.pass, _ = r.BasicAuth()
+		rec.agent = r.UserAgent()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, rec
+}
+
+func TestClientRequests(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		response string
+		call     func(c *Client) (any, error)
+		want     any
+		method   string
+		path     string
+		query    string
+		body     string
+	}{
+		{
+			name:     "search movies",
+			response: `[{"cat":"电影","sid":"1292052","name":"肖申克的救赎","rating":"9.7","img":"i","year":"1994"}]`,
+			call:     func(c *Client) (any, error) { return c.SearchMovies(ctx, "肖申克", 3) },
+			want:     []Movie{{Cat: "电影", SID: "1292052", Name: "肖申克的救赎", Rating: "9.7", Img: "i", Year: "1994"}},
+			method:   http.MethodGet,
+			path:     "/movies",
+			query:    "count=3&q=%E8%82%96%E7%94%B3%E5%85%8B",
+		},
+		{
+			name:     "movie escapes the id",
+			response: `{"sid":"1 2","name":"n"}`,
+			call:     func(c *Client) (any, error) { return c.Movie(ctx, "1 2") },
+			want:     MovieInfo{SID: "1 2", Name: "n"},
+			method:   http.MethodGet,
+			path:     "/movies/1%202",
+		},
+		{
+			name:     "book search omits zero paging",
+			response: `{"code":0,"msg":"","books":[{"id":"1","title":"t"}]}`,
+			call:     func(c *Client) (any, error) { return c.SearchBooks(ctx, "go", 0, 0, true) },
+			want:     DoubanBookResult{Books: []DoubanBook{{ID: "1", Title: "t"}}},
+			method:   http.MethodGet,
+			path:     "/v2/book/search",
+			query:    "q=go&type=full",
+		},
+		{
+			name:     "batch posts json",
+			response: `{"results":[{"index":0,"type":"isbn","query":"9787111111111","book":{"id":"1"}}]}`,
+			call: func(c *Client) (any, error) {
+				return c.BatchBooks(ctx, BatchRequest{ISBNs: []string{"9787111111111"}})
+			},
+			want:   []BatchResult{{Type: "isbn", Query: "9787111111111", Book: &DoubanBook{ID: "1"}}},
+			method: http.MethodPost,
+			path:   "/v2/book/batch",
+			body:   `{"isbns":["9787111111111"],"ids":null}`,
+		},
+		{
+			name:     "browse",
+			response: `{"items":[],"total":0}`,
+			call: func(c *Client) (any, error) {
+				return c.Browse(ctx, BrowseParams{Type: "movie", Tag: "热门", Start: 20, Limit: 10})
+			},
+			want:   HotMediaResponse{Items: []HotMediaItem{}},
+			method: http.MethodGet,
+			path:   "/v2/media/browse",
+			query:  "limit=10&start=20&tag=%E7%83%AD%E9%97%A8&type=movie",
+		},
+		{
+			name:     "snapshot lists",
+			response: `{"lists":["hot-tv"]}`,
+			call:     func(c *Client) (any, error) { return c.SnapshotLists(ctx) },
+			want:     []string{"hot-tv"},
+			method:   http.MethodGet,
+			path:     "/v2/media/snapshots",
+		},
+		{
+			name:     "opf returns raw bytes",
+			response: `<package/>`,
+			call: func(c *Client) (any, error) {
+				b, err := c.BookOPF(ctx, "42", 3)
+				return string(b), err
+			},
+			want:   "<package/>",
+			method: http.MethodGet,
+			path:   "/v2/book/id/42.opf",
+			query:  "version=3",
+		},
+		{
+			name:     "graphql",
+			response: `{"data":{"movie":{"name":"n"}}}`,
+			call: func(c *Client) (any, error) {
+				resp, err := c.GraphQL(ctx, GraphQLRequest{Query: `{ movie(id: "1") { name } }`})
+				return string(resp.Data), err
+			},
+			want:   `{"movie":{"name":"n"}}`,
+			method: http.MethodPost,
+			path:   "/graphql",
+			body:   `{"query":"{ movie(id: \"1\") { name } }"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, rec := newTestServer(t, http.StatusOK, tt.response)
+			got, err := tt.call(New(srv.URL + "/"))
+			if err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("result = %#v, want %#v", got, tt.want)
+			}
+			if rec.method != tt.method || rec.path != tt.path || rec.query != tt.query {
+				t.Errorf("request = %s %s?%s, want %s %s?%s", rec.method, rec.path, rec.query, tt.method, tt.path, tt.query)
+			}
+			if tt.body != "" && rec.body != tt.body {
+				t.Errorf("body = %s, want %s", rec.body, tt.body)
+			}
+			if rec.agent != "douban-api-go/client" {
+				t.Errorf("user agent = %q", rec.agent)
+			}
+		})
+	}
+}
+
+func TestClientBasicAuth(t *testing.T) {
+	srv, rec := newTestServer(t, http.StatusOK, `[]`)
+	c := New(srv.URL, WithBasicAuth("user", "pass"), WithHTTPClient(srv.Client()))
+	if _, err := c.Suggest(context.Background(), "q", ""); err != nil {
+		t.Fatalf("Suggest: %v", err)
+	}
+	if rec.user != "user" || rec.pass != "pass" {
+		t.Errorf("basic auth = %q/%q", rec.user, rec.pass)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		want     APIError
+	}{
+		{"message", http.StatusBadRequest, `{"message":"count不能大于20"}`, APIError{StatusCode: 400, Message: "count不能大于20"}},
+		{"graphql errors", http.StatusBadRequest, `{"errors":[{"message":"syntax error"}]}`, APIError{StatusCode: 400, Message: "syntax error"}},
+		{"plain text", http.StatusBadGateway, `upstream down`, APIError{StatusCode: 502, Message: "Bad Gateway"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newTestServer(t, tt.status, tt.response)
+			_, err := New(srv.URL).Book(context.Background(), "1")
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want *APIError", err)
+			}
+			if *apiErr != tt.want {
+				t.Errorf("error = %+v, want %+v", *apiErr, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientDecodeError(t *testing.T) {
+	srv, _ := newTestServer(t, http.StatusOK, `{"sid":`)
+	if _, err := New(srv.URL).Movie(context.Background(), "1"); err == nil {
+		t.Fatal("Movie with truncated body returned nil error")
+	}
+}
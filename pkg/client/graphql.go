@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type GraphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+type GraphQLError struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+type GraphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
+}
+
+func (c *Client) GraphQL(ctx context.Context, req GraphQLRequest) (GraphQLResponse, error) {
+	var result GraphQLResponse
+	err := c.doJSON(ctx, http.MethodPost, "/graphql", nil, req, &result)
+	return result, err
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+type BrowseParams struct {
+	Type  string
+	Tag   string
+	Sort  string
+	Start int
+	Limit int
+}
+
+func (c *Client) HotTV(ctx context.Context, start, limit int) (HotMediaResponse, error) {
+	return c.hotMedia(ctx, "/v2/media/hot/tv", nil, start, limit)
+}
+
+func (c *Client) HotMovie(ctx context.Context, start, limit int) (HotMediaResponse, error) {
+	return c.hotMedia(ctx, "/v2/media/hot/movie", nil, start, limit)
+}
+
+func (c *Client) LatestMovie(ctx context.Context, start, limit int) (HotMediaResponse, error) {
+	return c.hotMedia(ctx, "/v2/media/latest/movie", nil, start, limit)
+}
+
+func (c *Client) HighRatingMovie(ctx context.Context, start, limit int) (HotMediaResponse, error) {
+	return c.hotMedia(ctx, "/v2/media/high-rating/movie", nil, start, limit)
+}
+
+func (c *Client) Browse(ctx context.Context, params BrowseParams) (HotMediaResponse, error) {
+	query := url.Values{}
+	for key, v := range map[string]string{"type": params.Type, "tag": params.Tag, "sort": params.Sort} {
+		if v != "" {
+			query.Set(key, v)
+		}
+	}
+	return c.hotMedia(ctx, "/v2/media/browse", query, params.Start, params.Limit)
+}
+
+func (c *Client) RecentHot(ctx context.Context, subject, category, mediaType string, start, limit int) (HotMediaResponse, error) {
+	query := url.Values{}
+	if category != "" {
+		query.Set("category", category)
+	}
+	if mediaType != "" {
+		query.Set("type", mediaType)
+	}
+	return c.hotMedia(ctx, "/v2/media/recent_hot/"+url.PathEscape(subject), query, start, limit)
+}
+
+func (c *Client) hotMedia(ctx context.Context, path string, query url.Values, start, limit int) (HotMediaResponse, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	setInt(query, "start", start)
+	setInt(query, "limit", limit)
+	var result HotMediaResponse
+	err := c.getJSON(ctx, path, query, &result)
+	return result, err
+}
+
+func (c *Client) Top250(ctx context.Context, start, limit int) (Top250Response, error) {
+	query := url.Values{}
+	setInt(query, "start", start)
+	setInt(query, "limit", limit)
+	var result Top250Response
+	err := c.getJSON(ctx, "/v2/media/top250", query, &result)
+	return result, err
+}
+
+func (c *Client) Categories(ctx context.Context) ([]Catalog, error) {
+	var result []Catalog
+	err := c.getJSON(ctx, "/v2/media/categories", nil, &result)
+	return result, err
+}
+
+func (c *Client) SnapshotLists(ctx context.Context) ([]string, error) {
+	var result SnapshotLists
+	err := c.getJSON(ctx, "/v2/media/snapshots", nil, &result)
+	return result.Lists, err
+}
+
+func (c *Client) SnapshotIDs(ctx context.Context, list string) ([]string, error) {
+	var result SnapshotIDs
+	err := c.getJSON(ctx, "/v2/media/snapshots/"+url.PathEscape(list), nil, &result)
+	return result.IDs, err
+}
+
+func (c *Client) Snapshot(ctx context.Context, list, id string) (Snapshot, error) {
+	var result Snapshot
+	err := c.getJSON(ctx, "/v2/media/snapshots/"+url.PathEscape(list)+"/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) SnapshotDiff(ctx context.Context, list, from, to string) (SnapshotDiff, error) {
+	query := url.Values{}
+	if from != "" {
+		query.Set("from", from)
+	}
+	if to != "" {
+		query.Set("to", to)
+	}
+	var result SnapshotDiff
+	err := c.getJSON(ctx, "/v2/media/snapshots/"+url.PathEscape(list)+"/diff", query, &result)
+	return result, err
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) SearchMovies(ctx context.Context, q string, count int) ([]Movie, error) {
+	query := url.Values{"q": {q}}
+	setInt(query, "count", count)
+	var result []Movie
+	err := c.getJSON(ctx, "/movies", query, &result)
+	return result, err
+}
+
+func (c *Client) SearchMoviesFull(ctx context.Context, q string, count int) ([]MovieInfo, error) {
+	query := url.Values{"q": {q}, "type": {"full"}}
+	setInt(query, "count", count)
+	var result []MovieInfo
+	err := c.getJSON(ctx, "/movies", query, &result)
+	return result, err
+}
+
+func (c *Client) Movie(ctx context.Context, sid string) (MovieInfo, error) {
+	var result MovieInfo
+	err := c.getJSON(ctx, "/movies/"+url.PathEscape(sid), nil, &result)
+	return result, err
+}
+
+func (c *Client) MovieByIMDB(ctx context.Context, imdbID string) (MovieInfo, error) {
+	var result MovieInfo
+	err := c.getJSON(ctx, "/movies/imdb/"+url.PathEscape(imdbID), nil, &result)
+	return result, err
+}
+
+func (c *Client) MovieNFO(ctx context.Context, sid, kind string) ([]byte, error) {
+	query := url.Values{}
+	if kind != "" {
+		query.Set("type", kind)
+	}
+	return c.do(ctx, http.MethodGet, "/movies/"+url.PathEscape(sid)+".nfo", query, nil)
+}
+
+func (c *Client) Match(ctx context.Context, fileName string) (MatchResult, error) {
+	var result MatchResult
+	err := c.getJSON(ctx, "/match", url.Values{"q": {fileName}}, &result)
+	return result, err
+}
+
+func (c *Client) Celebrities(ctx context.Context, sid string) ([]Celebrity, error) {
+	var result []Celebrity
+	err := c.getJSON(ctx, "/movies/"+url.PathEscape(sid)+"/celebrities", nil, &result)
+	return result, err
+}
+
+func (c *Client) Celebrity(ctx context.Context, id string) (CelebrityInfo, error) {
+	var result CelebrityInfo
+	err := c.getJSON(ctx, "/celebrities/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) Photos(ctx context.Context, sid string) ([]Photo, error) {
+	var result []Photo
+	err := c.getJSON(ctx, "/photo/"+url.PathEscape(sid), nil, &result)
+	return result, err
+}
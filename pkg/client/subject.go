@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+func (c *Client) SearchMusic(ctx context.Context, q string, count int) (DoubanMusicResult, error) {
+	var result DoubanMusicResult
+	err := c.getJSON(ctx, "/v2/music/search", searchQuery(q, count), &result)
+	return result, err
+}
+
+func (c *Client) Music(ctx context.Context, id string) (DoubanMusic, error) {
+	var result DoubanMusic
+	err := c.getJSON(ctx, "/v2/music/id/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) SearchGames(ctx context.Context, q string, count int) (DoubanGameResult, error) {
+	var result DoubanGameResult
+	err := c.getJSON(ctx, "/v2/game/search", searchQuery(q, count), &result)
+	return result, err
+}
+
+func (c *Client) Game(ctx context.Context, id string) (DoubanGame, error) {
+	var result DoubanGame
+	err := c.getJSON(ctx, "/v2/game/id/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) SearchDramas(ctx context.Context, q string, count int) (DoubanDramaResult, error) {
+	var result DoubanDramaResult
+	err := c.getJSON(ctx, "/v2/drama/search", searchQuery(q, count), &result)
+	return result, err
+}
+
+func (c *Client) Drama(ctx context.Context, id string) (DoubanDrama, error) {
+	var result DoubanDrama
+	err := c.getJSON(ctx, "/v2/drama/id/"+url.PathEscape(id), nil, &result)
+	return result, err
+}
+
+func (c *Client) Suggest(ctx context.Context, q, subjectType string) ([]SuggestItem, error) {
+	query := url.Values{"q": {q}}
+	if subjectType != "" {
+		query.Set("type", subjectType)
+	}
+	var result []SuggestItem
+	err := c.getJSON(ctx, "/v2/suggest", query, &result)
+	return result, err
+}
+
+func searchQuery(q string, count int) url.Values {
+	query := url.Values{"q": {q}}
+	setInt(query, "count", count)
+	return query
+}
@@ -0,0 +1,519 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Movie struct {
+	Cat    string `json:"cat"`
+	SID    string `json:"sid"`
+	Name   string `json:"name"`
+	Rating string `json:"rating"`
+	Img    string `json:"img"`
+	Year   string `json:"year"`
+}
+
+type MovieInfo struct {
+	SID          string      `json:"sid"`
+	Name         string      `json:"name"`
+	OriginalName string      `json:"originalName"`
+	Rating       string      `json:"rating"`
+	Votes        string      `json:"votes"`
+	Img          string      `json:"img"`
+	Year         string      `json:"year"`
+	Intro        string      `json:"intro"`
+	Director     string      `json:"director"`
+	Writer       string      `json:"writer"`
+	Actor        string      `json:"actor"`
+	Genre        string      `json:"genre"`
+	Site         string      `json:"site"`
+	Country      string      `json:"country"`
+	Language     string      `json:"language"`
+	Screen       string      `json:"screen"`
+	Duration     string      `json:"duration"`
+	Episodes     string      `json:"episodes"`
+	Subname      string      `json:"subname"`
+	IMDB         string      `json:"imdb"`
+	Celebrities  []Celebrity `json:"celebrities"`
+}
+
+type Celebrity struct {
+	ID   string `json:"id"`
+	Img  string `json:"img"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+type CelebrityInfo struct {
+	ID            string `json:"id"`
+	Img           string `json:"img"`
+	Name          string `json:"name"`
+	Role          string `json:"role"`
+	Intro         string `json:"intro"`
+	Gender        string `json:"gender"`
+	Constellation string `json:"constellation"`
+	Birthdate     string `json:"birthdate"`
+	Birthplace    string `json:"birthplace"`
+	Nickname      string `json:"nickname"`
+	IMDB          string `json:"imdb"`
+	Family        string `json:"family"`
+}
+
+type Photo struct {
+	ID     string `json:"id"`
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+	Size   string `json:"size"`
+	Width  string `json:"width"`
+	Height string `json:"height"`
+}
+
+type ParsedName struct {
+	Raw          string   `json:"raw"`
+	Title        string   `json:"title"`
+	LocalTitle   string   `json:"localTitle,omitempty"`
+	ForeignTitle string   `json:"foreignTitle,omitempty"`
+	Year         string   `json:"year,omitempty"`
+	Season       int      `json:"season,omitempty"`
+	Episode      int      `json:"episode,omitempty"`
+	Resolution   string   `json:"resolution,omitempty"`
+	Tags         []string `json:"tags"`
+}
+
+type MatchCandidate struct {
+	SID          string  `json:"sid"`
+	Cat          string  `json:"cat"`
+	Name         string  `json:"name"`
+	OriginalName string  `json:"originalName"`
+	Year         string  `json:"year"`
+	Rating       string  `json:"rating"`
+	Img          string  `json:"img"`
+	Score        float64 `json:"score"`
+}
+
+type MatchResult struct {
+	Query        ParsedName       `json:"query"`
+	Best         *MatchCandidate  `json:"best"`
+	Confidence   float64          `json:"confidence"`
+	Alternatives []MatchCandidate `json:"alternatives"`
+}
+
+type DoubanBookResult struct {
+	Code  uint32       `json:"code"`
+	Msg   string       `json:"msg"`
+	Books []DoubanBook `json:"books"`
+}
+
+type DoubanBook struct {
+	ID          string           `json:"id"`
+	Author      []string         `json:"author"`
+	Authors     []AuthorRef      `json:"authors"`
+	AuthorIntro string           `json:"author_intro"`
+	Translators []string         `json:"translators"`
+	Images      BookImage        `json:"images"`
+	Binding     string           `json:"binding"`
+	Category    string           `json:"category"`
+	Rating      BookRating       `json:"rating"`
+	ISBN13      string           `json:"isbn13"`
+	Pages       string           `json:"pages"`
+	PageCount   int              `json:"page_count"`
+	Price       string           `json:"price"`
+	PriceInfo   *BookPrice       `json:"price_info"`
+	Pubdate     string           `json:"pubdate"`
+	PubdateInfo *BookPartialDate `json:"pubdate_info"`
+	BindingType string           `json:"binding_type"`
+	Publisher   string           `json:"publisher"`
+	Producer    string           `json:"producer"`
+	Serials     string           `json:"serials"`
+	SeriesID    string           `json:"series_id"`
+	WorksID     string           `json:"works_id"`
+	Subtitle    string           `json:"subtitle"`
+	Summary     string           `json:"summary"`
+	Catalog     string           `json:"catalog"`
+	Title       string           `json:"title"`
+	Tags        []BookTag        `json:"tags"`
+	Origin      string           `json:"origin"`
+}
+
+type BookPrice struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+type BookPartialDate struct {
+	Year      int    `json:"year"`
+	Month     int    `json:"month,omitempty"`
+	Day       int    `json:"day,omitempty"`
+	Precision string `json:"precision"`
+}
+
+type BookImage struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type BookTag struct {
+	Name string `json:"name"`
+}
+
+type BookRating struct {
+	Average float32 `json:"average"`
+}
+
+type BookSeries struct {
+	ID    string     `json:"id"`
+	Title string     `json:"title"`
+	Total int        `json:"total"`
+	Books []BookItem `json:"books"`
+}
+
+type BookItem struct {
+	Position int        `json:"position"`
+	Volume   int        `json:"volume,omitempty"`
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	Pub      string     `json:"pub"`
+	Rating   BookRating `json:"rating"`
+	Image    string     `json:"image"`
+}
+
+type BookEdition struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Subtitle  string `json:"subtitle"`
+	ISBN13    string `json:"isbn13"`
+	Publisher string `json:"publisher"`
+	Pubdate   string `json:"pubdate"`
+	Binding   string `json:"binding"`
+	Image     string `json:"image"`
+}
+
+type BatchRequest struct {
+	ISBNs []string `json:"isbns"`
+	IDs   []string `json:"ids"`
+}
+
+type BatchResult struct {
+	Index int         `json:"index"`
+	Type  string      `json:"type"`
+	Query string      `json:"query"`
+	Book  *DoubanBook `json:"book,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+type CommentList struct {
+	Start    int       `json:"start"`
+	Count    int       `json:"count"`
+	Total    int       `json:"total"`
+	Comments []Comment `json:"comments"`
+}
+
+type Comment struct {
+	ID       string `json:"id"`
+	Author   string `json:"author"`
+	AuthorID string `json:"author_id"`
+	Rating   int    `json:"rating"`
+	Date     string `json:"date"`
+	Votes    int    `json:"votes"`
+	Title    string `json:"title,omitempty"`
+	Content  string `json:"content"`
+	URL      string `json:"url,omitempty"`
+}
+
+type AuthorRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Author struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Photo       string      `json:"photo"`
+	Gender      string      `json:"gender"`
+	Birthdate   string      `json:"birthdate"`
+	Deathdate   string      `json:"deathdate"`
+	Birthplace  string      `json:"birthplace"`
+	Nationality string      `json:"nationality"`
+	Intro       string      `json:"intro"`
+	Works       AuthorWorks `json:"works"`
+}
+
+type AuthorWorks struct {
+	Start int        `json:"start"`
+	Count int        `json:"count"`
+	Total int        `json:"total"`
+	Books []BookItem `json:"books"`
+}
+
+type HotMediaResponse struct {
+	Category      string           `json:"category"`
+	Type          string           `json:"type"`
+	Total         int              `json:"total"`
+	Tags          []HotMediaTag    `json:"tags,omitempty"`
+	RecommendTags []HotMediaOption `json:"recommend_tags,omitempty"`
+	Items         []HotMediaItem   `json:"items"`
+}
+
+type HotMediaTag struct {
+	Category string           `json:"category"`
+	Selected bool             `json:"selected"`
+	Title    string           `json:"title"`
+	Types    []HotMediaOption `json:"types,omitempty"`
+}
+
+type HotMediaOption struct {
+	Selected bool   `json:"selected"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+}
+
+type HotMediaItem struct {
+	ID               string          `json:"id"`
+	Type             string          `json:"type"`
+	Title            string          `json:"title"`
+	Year             string          `json:"year,omitempty"`
+	URI              string          `json:"uri,omitempty"`
+	URL              string          `json:"url,omitempty"`
+	CardSubtitle     string          `json:"card_subtitle,omitempty"`
+	Rating           *HotMediaRating `json:"rating,omitempty"`
+	Pic              *HotMediaPic    `json:"pic,omitempty"`
+	Playable         *bool           `json:"playable,omitempty"`
+	NullRatingReason string          `json:"null_rating_reason,omitempty"`
+	EpisodesInfo     string          `json:"episodes_info,omitempty"`
+	HonorInfos       json.RawMessage `json:"honor_infos,omitempty"`
+	Detail           *MovieInfo      `json:"detail,omitempty"`
+}
+
+type HotMediaRating struct {
+	Count     int     `json:"count"`
+	Max       int     `json:"max"`
+	StarCount float64 `json:"star_count"`
+	Value     float64 `json:"value"`
+}
+
+type HotMediaPic struct {
+	Large  string `json:"large"`
+	Normal string `json:"normal"`
+}
+
+type Top250Response struct {
+	Total int          `json:"total"`
+	Items []Top250Item `json:"items"`
+}
+
+type Top250Item struct {
+	Rank          int        `json:"rank"`
+	ID            string     `json:"id"`
+	Title         string     `json:"title"`
+	OriginalTitle string     `json:"original_title,omitempty"`
+	OtherTitles   []string   `json:"other_titles,omitempty"`
+	Year          string     `json:"year,omitempty"`
+	Rating        float64    `json:"rating"`
+	Votes         int        `json:"votes"`
+	Quote         string     `json:"quote,omitempty"`
+	Pic           string     `json:"pic,omitempty"`
+	URL           string     `json:"url,omitempty"`
+	Detail        *MovieInfo `json:"detail,omitempty"`
+}
+
+type Catalog struct {
+	Subject    string            `json:"subject"`
+	Categories []CatalogCategory `json:"categories"`
+}
+
+type CatalogCategory struct {
+	Category string           `json:"category"`
+	Title    string           `json:"title"`
+	Types    []HotMediaOption `json:"types"`
+}
+
+type Snapshot struct {
+	ID      string         `json:"id"`
+	List    string         `json:"list"`
+	TakenAt time.Time      `json:"taken_at"`
+	Total   int            `json:"total"`
+	Items   []HotMediaItem `json:"items"`
+}
+
+type SnapshotDiff struct {
+	List          string               `json:"list"`
+	From          string               `json:"from"`
+	To            string               `json:"to"`
+	Entered       []SnapshotDiffItem   `json:"entered"`
+	Dropped       []SnapshotDiffItem   `json:"dropped"`
+	Moved         []SnapshotRankMove   `json:"moved"`
+	RatingChanged []SnapshotRatingMove `json:"rating_changed"`
+}
+
+type SnapshotDiffItem struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Rank  int    `json:"rank"`
+}
+
+type SnapshotRankMove struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	From  int    `json:"from"`
+	To    int    `json:"to"`
+	Delta int    `json:"delta"`
+}
+
+type SnapshotRatingMove struct {
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Delta float64 `json:"delta"`
+}
+
+type SuggestItem struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	SubTitle string `json:"sub_title"`
+	Year     string `json:"year"`
+	Episode  string `json:"episode"`
+	Cover    string `json:"cover"`
+	Type     string `json:"type"`
+}
+
+type DoubanMusicResult struct {
+	Code   uint32        `json:"code"`
+	Msg    string        `json:"msg"`
+	Musics []DoubanMusic `json:"musics"`
+}
+
+type DoubanMusic struct {
+	ID        string       `json:"id"`
+	Title     string       `json:"title"`
+	AltTitle  string       `json:"alt_title"`
+	Artists   []string     `json:"artists"`
+	Genre     string       `json:"genre"`
+	Version   string       `json:"version"`
+	Media     string       `json:"media"`
+	Pubdate   string       `json:"pubdate"`
+	Publisher string       `json:"publisher"`
+	Discs     string       `json:"discs"`
+	Barcode   string       `json:"barcode"`
+	ISRC      string       `json:"isrc"`
+	Rating    MusicRating  `json:"rating"`
+	Images    MusicImage   `json:"images"`
+	Tracks    []MusicTrack `json:"tracks"`
+	Summary   string       `json:"summary"`
+	Tags      []MusicTag   `json:"tags"`
+}
+
+type MusicTrack struct {
+	Index int    `json:"index"`
+	Title string `json:"title"`
+}
+
+type MusicImage struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type MusicTag struct {
+	Name string `json:"name"`
+}
+
+type MusicRating struct {
+	Average float32 `json:"average"`
+}
+
+type DoubanGameResult struct {
+	Code  uint32       `json:"code"`
+	Msg   string       `json:"msg"`
+	Games []DoubanGame `json:"games"`
+}
+
+type DoubanGame struct {
+	ID           string           `json:"id"`
+	Title        string           `json:"title"`
+	Aliases      []string         `json:"aliases"`
+	Genres       []string         `json:"genres"`
+	Platforms    []string         `json:"platforms"`
+	Developers   []string         `json:"developers"`
+	Publishers   []string         `json:"publishers"`
+	ReleaseDates []string         `json:"release_dates"`
+	Rating       GameRating       `json:"rating"`
+	Images       GameImage        `json:"images"`
+	Screenshots  []GameScreenshot `json:"screenshots"`
+	Summary      string           `json:"summary"`
+	Tags         []GameTag        `json:"tags"`
+}
+
+type GameScreenshot struct {
+	Thumb string `json:"thumb"`
+	Large string `json:"large"`
+}
+
+type GameImage struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type GameTag struct {
+	Name string `json:"name"`
+}
+
+type GameRating struct {
+	Average float32 `json:"average"`
+	Votes   int     `json:"votes"`
+}
+
+type DoubanDramaResult struct {
+	Code   uint32        `json:"code"`
+	Msg    string        `json:"msg"`
+	Dramas []DoubanDrama `json:"dramas"`
+}
+
+type DoubanDrama struct {
+	ID        string      `json:"id"`
+	Title     string      `json:"title"`
+	Aliases   []string    `json:"aliases"`
+	Directors []string    `json:"directors"`
+	Writers   []string    `json:"writers"`
+	Cast      []string    `json:"cast"`
+	Genres    []string    `json:"genres"`
+	Languages []string    `json:"languages"`
+	Troupes   []string    `json:"troupes"`
+	Premiere  string      `json:"premiere"`
+	Venue     string      `json:"venue"`
+	Rating    DramaRating `json:"rating"`
+	Images    DramaImage  `json:"images"`
+	Summary   string      `json:"summary"`
+	Tags      []DramaTag  `json:"tags"`
+}
+
+type DramaImage struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
+
+type DramaTag struct {
+	Name string `json:"name"`
+}
+
+type DramaRating struct {
+	Average float32 `json:"average"`
+	Votes   int     `json:"votes"`
+}
+type SnapshotLists struct {
+	Lists []string `json:"lists"`
+}
+
+type SnapshotIDs struct {
+	List string   `json:"list"`
+	IDs  []string `json:"ids"`
+}
+
+type batchResponse struct {
+	Results []BatchResult `json:"results"`
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/haigeek/douban-api-go/internal/api/movie"
+	"github.com/haigeek/douban-api-go/internal/book"
+	"github.com/haigeek/douban-api-go/internal/drama"
+	"github.com/haigeek/douban-api-go/internal/game"
+	"github.com/haigeek/douban-api-go/internal/media"
+	"github.com/haigeek/douban-api-go/internal/music"
+	"github.com/haigeek/douban-api-go/internal/suggest"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	rawType  = reflect.TypeOf(json.RawMessage(nil))
+)
+
+func fill(v reflect.Value, depth int) {
+	if v.Type() == timeType {
+		v.Set(reflect.ValueOf(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)))
+		return
+	}
+	if v.Type() == rawType {
+		v.SetBytes([]byte(`{"k":"v"}`))
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("s")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(7)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(7)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Pointer:
+		if depth > 4 {
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		fill(p.Elem(), depth+1)
+		v.Set(p)
+	case reflect.Slice:
+		if depth > 4 {
+			return
+		}
+		s := reflect.MakeSlice(v.Type(), 1, 1)
+		fill(s.Index(0), depth+1)
+		v.Set(s)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		key := reflect.New(v.Type().Key()).Elem()
+		val := reflect.New(v.Type().Elem()).Elem()
+		fill(key, depth+1)
+		fill(val, depth+1)
+		m.SetMapIndex(key, val)
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i), depth+1)
+			}
+		}
+	}
+}
+
+func roundTrip[S, C any](t *testing.T, name string) {
+	t.Helper()
+	var server S
+	fill(reflect.ValueOf(&server).Elem(), 0)
+	want, err := json.Marshal(server)
+	if err != nil {
+		t.Fatalf("%s: marshal server value: %v", name, err)
+	}
+
+	var decoded C
+	dec := json.NewDecoder(bytes.NewReader(want))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&decoded); err != nil {
+		t.Errorf("%s: client type cannot decode server JSON: %v", name, err)
+		return
+	}
+	got, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("%s: marshal client value: %v", name, err)
+	}
+
+	var wantAny, gotAny any
+	_ = json.Unmarshal(want, &wantAny)
+	_ = json.Unmarshal(got, &gotAny)
+	if !reflect.DeepEqual(wantAny, gotAny) {
+		t.Errorf("%s: client round trip lost data\nserver: %s\nclient: %s", name, want, got)
+	}
+}
+
+func TestTypesMatchServer(t *testing.T) {
+	roundTrip[movie.Movie, Movie](t, "Movie")
+	roundTrip[movie.MovieInfo, MovieInfo](t, "MovieInfo")
+	roundTrip[movie.Celebrity, Celebrity](t, "Celebrity")
+	roundTrip[movie.CelebrityInfo, CelebrityInfo](t, "CelebrityInfo")
+	roundTrip[movie.Photo, Photo](t, "Photo")
+	roundTrip[movie.MatchResult, MatchResult](t, "MatchResult")
+
+	roundTrip[book.DoubanBookResult, DoubanBookResult](t, "DoubanBookResult")
+	roundTrip[book.Series, BookSeries](t, "BookSeries")
+	roundTrip[book.Edition, BookEdition](t, "BookEdition")
+	roundTrip[book.BatchRequest, BatchRequest](t, "BatchRequest")
+	roundTrip[book.BatchResult, BatchResult](t, "BatchResult")
+	roundTrip[book.CommentList, CommentList](t, "CommentList")
+	roundTrip[book.Author, Author](t, "Author")
+
+	roundTrip[media.HotMediaResponse, HotMediaResponse](t, "HotMediaResponse")
+	roundTrip[media.Top250Response, Top250Response](t, "Top250Response")
+	roundTrip[media.Catalog, Catalog](t, "Catalog")
+	roundTrip[media.Snapshot, Snapshot](t, "Snapshot")
+	roundTrip[media.SnapshotDiff, SnapshotDiff](t, "SnapshotDiff")
+
+	roundTrip[suggest.Item, SuggestItem](t, "SuggestItem")
+	roundTrip[music.DoubanMusicResult, DoubanMusicResult](t, "DoubanMusicResult")
+	roundTrip[game.DoubanGameResult, DoubanGameResult](t, "DoubanGameResult")
+	roundTrip[drama.DoubanDramaResult, DoubanDramaResult](t, "DoubanDramaResult")
+}